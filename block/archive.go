@@ -0,0 +1,353 @@
+package block
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// ArchiveVersion is the version of the chain archive format written by ExportBlocks.
+const ArchiveVersion = 1
+
+// maxArchiveRecordSize is the maximum size of a single record of a chain archive.
+const maxArchiveRecordSize = 256 << 20
+
+// archiveMagic identifies chain archives.
+var archiveMagic = []byte("RKARCHIV")
+
+var (
+	// ErrInvalidArchive is returned when the input is not a valid chain archive.
+	ErrInvalidArchive = errors.New("invalid chain archive")
+
+	// ErrArchiveChecksum is returned when the checksum of a chain archive doesn't match its content.
+	ErrArchiveChecksum = errors.New("chain archive checksum mismatch")
+)
+
+type archiveRecord interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// ExportBlocks writes blocks from the store, with their signatures and responses, to w as a chain
+// archive. Blocks are exported from height from to height to, inclusive. If to is 0, blocks are
+// exported up to the last block of the state.
+//
+// A chain archive starts with a magic string, followed by an ArchiveHeader, an ArchiveBlock for
+// each block and an ArchiveTrailer, each prefixed by its length as an unsigned varint. The trailer
+// holds the SHA-256 checksum of all preceding bytes.
+func ExportBlocks(ctx context.Context, s store.Store, w io.Writer, from, to uint64) error {
+	st, err := s.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if to == 0 {
+		to = st.LastBlockHeight
+	}
+	if from < st.InitialHeight || from > to || to > st.LastBlockHeight {
+		return fmt.Errorf("cannot export blocks %d to %d, initial height is %d and last block height is %d",
+			from, to, st.InitialHeight, st.LastBlockHeight)
+	}
+
+	checksum := sha256.New()
+	hw := io.MultiWriter(w, checksum)
+	if _, err := hw.Write(archiveMagic); err != nil {
+		return err
+	}
+	err = writeArchiveRecord(hw, &pb.ArchiveHeader{
+		Version:    ArchiveVersion,
+		ChainId:    st.ChainID,
+		FromHeight: from,
+		ToHeight:   to,
+	})
+	if err != nil {
+		return err
+	}
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		record, err := loadArchiveBlock(ctx, s, height)
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveRecord(hw, record); err != nil {
+			return err
+		}
+	}
+	return writeArchiveRecord(w, &pb.ArchiveTrailer{
+		Blocks:   to - from + 1,
+		Checksum: checksum.Sum(nil),
+	})
+}
+
+func loadArchiveBlock(ctx context.Context, s store.Store, height uint64) (*pb.ArchiveBlock, error) {
+	header, data, err := s.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block at height %d: %w", height, err)
+	}
+	signature, err := s.GetSignature(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signature at height %d: %w", height, err)
+	}
+	responses, err := s.GetBlockResponses(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block responses at height %d: %w", height, err)
+	}
+	pHeader, err := header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ArchiveBlock{
+		Header:    pHeader,
+		Data:      data.ToProto(),
+		Signature: *signature,
+		Responses: responses,
+	}, nil
+}
+
+// ImportBlocks reads a chain archive written by ExportBlocks from r and saves its blocks in the
+// store, updating the state after each block. Blocks already in the store are skipped, and the
+// archive must not start above the next block height of the state.
+//
+// Every block is validated against the state, must be signed by the sequencer of the genesis and
+// must link to the previous block. If exec is not nil, blocks are executed against the application
+// and the results have to match the archived responses. Otherwise, archived responses are trusted,
+// and the application state has to be restored separately, to the height of the returned state.
+//
+// Blocks are saved as they are read, so if the archive is found to be truncated or corrupted, the
+// blocks imported so far are kept; the import can be resumed with a valid archive.
+func ImportBlocks(ctx context.Context, s store.Store, genesis *cmtypes.GenesisDoc, r io.Reader, exec *state.BlockExecutor) (types.State, error) {
+	ar := &archiveReader{r: bufio.NewReader(r), checksum: sha256.New()}
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(ar, magic); err != nil || !bytes.Equal(magic, archiveMagic) {
+		return types.State{}, fmt.Errorf("%w: missing magic string", ErrInvalidArchive)
+	}
+	var hdr pb.ArchiveHeader
+	if err := readArchiveRecord(ar, &hdr); err != nil {
+		return types.State{}, err
+	}
+	if hdr.Version != ArchiveVersion {
+		return types.State{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidArchive, hdr.Version)
+	}
+	if hdr.ChainId != genesis.ChainID {
+		return types.State{}, fmt.Errorf("%w: chain ID %q doesn't match genesis chain ID %q", ErrInvalidArchive, hdr.ChainId, genesis.ChainID)
+	}
+	if hdr.FromHeight == 0 || hdr.FromHeight > hdr.ToHeight {
+		return types.State{}, fmt.Errorf("%w: invalid block range %d to %d", ErrInvalidArchive, hdr.FromHeight, hdr.ToHeight)
+	}
+
+	st, err := s.GetState(ctx)
+	if errors.Is(err, ds.ErrNotFound) {
+		st, err = types.NewFromGenesisDoc(genesis)
+		if err == nil && exec != nil {
+			var res *abci.ResponseInitChain
+			if res, err = exec.InitChain(genesis); err == nil {
+				err = updateState(&st, res)
+			}
+		}
+	}
+	if err != nil {
+		return types.State{}, fmt.Errorf("failed to load state: %w", err)
+	}
+	if hdr.FromHeight > st.LastBlockHeight+1 {
+		return types.State{}, fmt.Errorf("archive starts at height %d, but next block height is %d", hdr.FromHeight, st.LastBlockHeight+1)
+	}
+	s.SetHeight(ctx, st.LastBlockHeight)
+
+	im := &importer{store: s, genesis: genesis, exec: exec, execute: exec != nil, state: st}
+	if exec == nil {
+		im.exec = state.NewBlockExecutor(nil, genesis.ChainID, nil, nil, nil, nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+	}
+	if st.LastBlockHeight >= st.InitialHeight {
+		if im.prev, _, err = s.GetBlockData(ctx, st.LastBlockHeight); err != nil {
+			return types.State{}, fmt.Errorf("failed to load block at height %d: %w", st.LastBlockHeight, err)
+		}
+	}
+	for height := hdr.FromHeight; height <= hdr.ToHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return im.state, err
+		}
+		var record pb.ArchiveBlock
+		if err := readArchiveRecord(ar, &record); err != nil {
+			return im.state, err
+		}
+		if height <= im.state.LastBlockHeight {
+			continue
+		}
+		if err := im.importBlock(ctx, height, &record); err != nil {
+			return im.state, fmt.Errorf("failed to import block at height %d: %w", height, err)
+		}
+	}
+
+	sum := ar.checksum.Sum(nil)
+	ar.checksum = nil
+	var trailer pb.ArchiveTrailer
+	if err := readArchiveRecord(ar, &trailer); err != nil {
+		return im.state, err
+	}
+	if trailer.Blocks != hdr.ToHeight-hdr.FromHeight+1 || !bytes.Equal(trailer.Checksum, sum) {
+		return im.state, ErrArchiveChecksum
+	}
+	return im.state, nil
+}
+
+// importer imports blocks of a chain archive one by one.
+type importer struct {
+	store   store.Store
+	genesis *cmtypes.GenesisDoc
+	exec    *state.BlockExecutor
+	execute bool
+	state   types.State
+	prev    *types.SignedHeader
+}
+
+func (im *importer) importBlock(ctx context.Context, height uint64, record *pb.ArchiveBlock) error {
+	if record.Header == nil || record.Data == nil || record.Responses == nil {
+		return fmt.Errorf("%w: incomplete block", ErrInvalidArchive)
+	}
+	header := new(types.SignedHeader)
+	if err := header.FromProto(record.Header); err != nil {
+		return err
+	}
+	data := new(types.Data)
+	if err := data.FromProto(record.Data); err != nil {
+		return err
+	}
+	if header.Height() != height {
+		return fmt.Errorf("%w: unexpected block height %d", ErrInvalidArchive, header.Height())
+	}
+	if err := im.verify(header); err != nil {
+		return err
+	}
+
+	var (
+		newState  types.State
+		responses *abci.ResponseFinalizeBlock
+		err       error
+	)
+	if im.execute {
+		newState, responses, err = im.exec.ApplyBlock(ctx, im.state, header, data)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(responses.AppHash, record.Responses.AppHash) {
+			return fmt.Errorf("app hash mismatch: expected %X, got %X", record.Responses.AppHash, responses.AppHash)
+		}
+	} else {
+		if im.prev == nil {
+			// the application state before the first block is attested by the sequencer
+			im.state.AppHash = header.AppHash
+			im.state.LastResultsHash = header.LastResultsHash
+		}
+		if err := im.exec.Validate(im.state, header, data); err != nil {
+			return err
+		}
+		responses = record.Responses
+		if newState, err = im.exec.ApplyBlockResponses(im.state, header, data, responses); err != nil {
+			return err
+		}
+	}
+
+	signature := types.Signature(record.Signature)
+	if err := im.store.SaveBlockData(ctx, header, data, &signature); err != nil {
+		return fmt.Errorf("failed to save block: %w", err)
+	}
+	if im.execute {
+		if _, _, err := im.exec.Commit(ctx, newState, header, data, responses); err != nil {
+			return fmt.Errorf("failed to commit: %w", err)
+		}
+	}
+	if err := im.store.SaveBlockResponses(ctx, height, responses); err != nil {
+		return fmt.Errorf("failed to save block responses: %w", err)
+	}
+	im.store.SetHeight(ctx, height)
+	newState.DAHeight = im.state.DAHeight
+	if err := saveState(ctx, im.store, newState); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	im.state = newState
+	im.prev = header
+	return nil
+}
+
+// verify checks that header is signed by the sequencer and links to the previous header.
+func (im *importer) verify(header *types.SignedHeader) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(im.genesis.Validators) > 0 && !bytes.Equal(header.ProposerAddress, im.genesis.Validators[0].Address) {
+		return fmt.Errorf("unexpected proposer %X", header.ProposerAddress)
+	}
+	if im.prev != nil {
+		return im.prev.Verify(header)
+	}
+	return nil
+}
+
+func writeArchiveRecord(w io.Writer, record archiveRecord) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(bz)))); err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+func readArchiveRecord(r *archiveReader, record archiveRecord) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read record length: %w", ErrInvalidArchive, err)
+	}
+	if size > maxArchiveRecordSize {
+		return fmt.Errorf("%w: record of %d bytes exceeds maximum size", ErrInvalidArchive, size)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return fmt.Errorf("%w: failed to read record: %w", ErrInvalidArchive, err)
+	}
+	if err := record.Unmarshal(bz); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	return nil
+}
+
+// archiveReader reads a chain archive, computing the checksum of the bytes read so far.
+type archiveReader struct {
+	r        *bufio.Reader
+	checksum hash.Hash
+}
+
+func (ar *archiveReader) Read(p []byte) (int, error) {
+	n, err := ar.r.Read(p)
+	if ar.checksum != nil {
+		ar.checksum.Write(p[:n])
+	}
+	return n, err
+}
+
+func (ar *archiveReader) ReadByte() (byte, error) {
+	b, err := ar.r.ReadByte()
+	if err == nil && ar.checksum != nil {
+		ar.checksum.Write([]byte{b})
+	}
+	return b, err
+}
@@ -0,0 +1,237 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+// archiveTestChain is a chain of blocks with consistent responses, as saved by a syncing node.
+type archiveTestChain struct {
+	genesis   *cmtypes.GenesisDoc
+	headers   []*types.SignedHeader
+	data      []*types.Data
+	responses []*abci.ResponseFinalizeBlock
+}
+
+func newArchiveTestChain(t *testing.T, n int) *archiveTestChain {
+	t.Helper()
+	genesis, privKey := types.GetGenesisWithPrivkey("")
+	c := &archiveTestChain{genesis: genesis}
+
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1, PrivKey: privKey})
+	// results hash set by InitChain
+	header.LastResultsHash = merkle.HashFromByteSlices(nil)
+	signature, err := types.GetSignature(header.Header, privKey)
+	require.NoError(t, err)
+	header.Signature = *signature
+	for i := 0; i < n; i++ {
+		resp := &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Data: types.GetRandomBytes(8)}},
+			AppHash:   types.GetRandomBytes(32),
+		}
+		c.headers = append(c.headers, header)
+		c.data = append(c.data, data)
+		c.responses = append(c.responses, resp)
+
+		header, data = types.GetRandomNextBlock(header, data, privKey, resp.AppHash, 1)
+	}
+	return c
+}
+
+// save saves the chain in s, along with the state after the last block.
+func (c *archiveTestChain) save(t *testing.T, s store.Store) {
+	t.Helper()
+	ctx := context.Background()
+	exec := state.NewBlockExecutor(nil, c.genesis.ChainID, nil, nil, nil, nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+	st, err := types.NewFromGenesisDoc(c.genesis)
+	require.NoError(t, err)
+	for i, header := range c.headers {
+		require.NoError(t, s.SaveBlockData(ctx, header, c.data[i], &header.Signature))
+		require.NoError(t, s.SaveBlockResponses(ctx, header.Height(), c.responses[i]))
+		st, err = exec.ApplyBlockResponses(st, header, c.data[i], c.responses[i])
+		require.NoError(t, err)
+	}
+	s.SetHeight(ctx, st.LastBlockHeight)
+	require.NoError(t, s.UpdateState(ctx, st))
+}
+
+// newArchiveTestExecutor returns an executor running blocks of the chain against a mocked
+// application, which responds to FinalizeBlock with finalize.
+func newArchiveTestExecutor(t *testing.T, c *archiveTestChain, finalize func(req *abci.RequestFinalizeBlock) *abci.ResponseFinalizeBlock) *state.BlockExecutor {
+	t.Helper()
+	app := &mocks.Application{}
+	app.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{AppHash: c.headers[0].AppHash}, nil)
+	app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
+	app.On("FinalizeBlock", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+			return finalize(req), nil
+		},
+	)
+	app.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
+	mpoolReaper := mempool.NewCListMempoolReaper(mpool, []byte(c.genesis.ChainID), nil, log.NewNopLogger())
+	return state.NewBlockExecutor(nil, c.genesis.ChainID, mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+}
+
+func newArchiveTestStore(t *testing.T) store.Store {
+	t.Helper()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	return store.New(kvStore)
+}
+
+func TestExportImportBlocks(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	chain := newArchiveTestChain(t, 5)
+	src := newArchiveTestStore(t)
+	chain.save(t, src)
+
+	var archive bytes.Buffer
+	assert.Error(ExportBlocks(ctx, src, &archive, 0, 3))
+	assert.Error(ExportBlocks(ctx, src, &archive, 4, 6))
+	archive.Reset()
+	require.NoError(ExportBlocks(ctx, src, &archive, 1, 0))
+
+	dst := newArchiveTestStore(t)
+	st, err := ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(archive.Bytes()), nil)
+	require.NoError(err)
+	assert.Equal(uint64(5), st.LastBlockHeight)
+	assert.EqualValues(chain.headers[4].Hash(), st.LastBlockID.Hash)
+	assert.Equal(chain.responses[4].AppHash, []byte(st.AppHash))
+
+	stored, err := dst.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(st.LastBlockHeight, stored.LastBlockHeight)
+	for i, header := range chain.headers {
+		h, d, err := dst.GetBlockData(ctx, header.Height())
+		require.NoError(err)
+		assert.Equal(header.Hash(), h.Hash())
+		assert.Equal(chain.data[i].Hash(), d.Hash())
+		signature, err := dst.GetSignature(ctx, header.Height())
+		require.NoError(err)
+		assert.Equal(header.Signature, *signature)
+		resp, err := dst.GetBlockResponses(ctx, header.Height())
+		require.NoError(err)
+		assert.Equal(chain.responses[i].AppHash, resp.AppHash)
+	}
+
+	// blocks already in the store are skipped
+	_, err = ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(archive.Bytes()), nil)
+	assert.NoError(err)
+}
+
+func TestImportBlocksResume(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	chain := newArchiveTestChain(t, 4)
+	src := newArchiveTestStore(t)
+	chain.save(t, src)
+
+	var first, second bytes.Buffer
+	require.NoError(ExportBlocks(ctx, src, &first, 1, 2))
+	require.NoError(ExportBlocks(ctx, src, &second, 3, 4))
+
+	dst := newArchiveTestStore(t)
+	_, err := ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(second.Bytes()), nil)
+	assert.ErrorContains(err, "next block height is 1")
+
+	st, err := ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(first.Bytes()), nil)
+	require.NoError(err)
+	assert.Equal(uint64(2), st.LastBlockHeight)
+	st, err = ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(second.Bytes()), nil)
+	require.NoError(err)
+	assert.Equal(uint64(4), st.LastBlockHeight)
+}
+
+func TestImportBlocksInvalidArchive(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	chain := newArchiveTestChain(t, 3)
+	src := newArchiveTestStore(t)
+	chain.save(t, src)
+	var archive bytes.Buffer
+	require.NoError(t, ExportBlocks(ctx, src, &archive, 1, 3))
+	valid := archive.Bytes()
+
+	_, err := ImportBlocks(ctx, newArchiveTestStore(t), chain.genesis, bytes.NewReader([]byte("not an archive")), nil)
+	assert.ErrorIs(err, ErrInvalidArchive)
+
+	// truncated archive keeps valid blocks
+	dst := newArchiveTestStore(t)
+	st, err := ImportBlocks(ctx, dst, chain.genesis, bytes.NewReader(valid[:len(valid)-10]), nil)
+	assert.ErrorIs(err, ErrInvalidArchive)
+	assert.Equal(uint64(3), st.LastBlockHeight)
+
+	corrupted := bytes.Clone(valid)
+	corrupted[len(corrupted)-1] ^= 0xff
+	_, err = ImportBlocks(ctx, newArchiveTestStore(t), chain.genesis, bytes.NewReader(corrupted), nil)
+	assert.ErrorIs(err, ErrArchiveChecksum)
+
+	genesis := *chain.genesis
+	genesis.ChainID = "other-chain"
+	_, err = ImportBlocks(ctx, newArchiveTestStore(t), &genesis, bytes.NewReader(valid), nil)
+	assert.ErrorIs(err, ErrInvalidArchive)
+
+	// blocks must be signed by the sequencer of the genesis
+	other, _ := types.GetGenesisWithPrivkey("")
+	other.ChainID = chain.genesis.ChainID
+	_, err = ImportBlocks(ctx, newArchiveTestStore(t), other, bytes.NewReader(valid), nil)
+	assert.ErrorContains(err, "unexpected proposer")
+}
+
+func TestImportBlocksExecute(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	chain := newArchiveTestChain(t, 3)
+	src := newArchiveTestStore(t)
+	chain.save(t, src)
+	var archive bytes.Buffer
+	require.NoError(ExportBlocks(ctx, src, &archive, 1, 3))
+
+	newExecutor := func(appHashes [][]byte) *state.BlockExecutor {
+		return newArchiveTestExecutor(t, chain, func(req *abci.RequestFinalizeBlock) *abci.ResponseFinalizeBlock {
+			resp := *chain.responses[req.Height-1]
+			resp.AppHash = appHashes[req.Height-1]
+			return &resp
+		})
+	}
+
+	appHashes := [][]byte{chain.responses[0].AppHash, chain.responses[1].AppHash, chain.responses[2].AppHash}
+	st, err := ImportBlocks(ctx, newArchiveTestStore(t), chain.genesis, bytes.NewReader(archive.Bytes()), newExecutor(appHashes))
+	require.NoError(err)
+	assert.Equal(uint64(3), st.LastBlockHeight)
+	assert.Equal(chain.responses[2].AppHash, []byte(st.AppHash))
+
+	// application diverging from the archived results stops the import
+	appHashes[1] = types.GetRandomBytes(32)
+	st, err = ImportBlocks(ctx, newArchiveTestStore(t), chain.genesis, bytes.NewReader(archive.Bytes()), newExecutor(appHashes))
+	assert.ErrorContains(err, "app hash mismatch")
+	assert.Equal(uint64(1), st.LastBlockHeight)
+}
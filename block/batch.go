@@ -2,6 +2,8 @@ package block
 
 import (
 	"sync"
+
+	"github.com/rollkit/rollkit/types"
 )
 
 // BatchQueue is a queue of transaction batches with timestamps
@@ -35,3 +37,39 @@ func (bq *BatchQueue) Next() *BatchWithTime {
 	bq.queue = bq.queue[1:]
 	return &batch
 }
+
+// Len returns the number of batches in the queue
+func (bq *BatchQueue) Len() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return len(bq.queue)
+}
+
+// removeIncluded removes batches with all transactions included in txs
+func (bq *BatchQueue) removeIncluded(txs types.Txs) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if len(bq.queue) == 0 {
+		return
+	}
+	included := make(map[string]struct{}, len(txs))
+	for _, tx := range txs {
+		included[string(tx)] = struct{}{}
+	}
+	queue := bq.queue[:0]
+	for _, batch := range bq.queue {
+		if !allIncluded(batch.Transactions, included) {
+			queue = append(queue, batch)
+		}
+	}
+	bq.queue = queue
+}
+
+func allIncluded(txs [][]byte, included map[string]struct{}) bool {
+	for _, tx := range txs {
+		if _, ok := included[string(tx)]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/rollkit/go-sequencing"
+
+	"github.com/rollkit/rollkit/types"
 )
 
 var (
@@ -67,3 +69,18 @@ func TestBatchQueue_Next(t *testing.T) {
 	require.Equal(t, batch2, *nextBatch, "Next should return the second batch added")
 	require.Empty(t, bq.queue, "BatchQueue should be empty after retrieving all batches")
 }
+
+func TestBatchQueue_removeIncluded(t *testing.T) {
+	bq := NewBatchQueue()
+	bq.AddBatch(batch1)
+	bq.AddBatch(batch2)
+
+	// batch is removed only if all its transactions are included
+	bq.removeIncluded(types.Txs{types.Tx("batch2"), types.Tx("other")})
+	require.Equal(t, 1, bq.Len())
+	require.Equal(t, batch1, *bq.Next())
+
+	bq.AddBatch(batch1)
+	bq.removeIncluded(types.Txs{types.Tx("other")})
+	require.Equal(t, 1, bq.Len())
+}
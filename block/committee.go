@@ -0,0 +1,96 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// ErrNoAttester is returned when headers have to be attested by the committee, but the attestations
+// of validators other than the sequencer can't be collected.
+var ErrNoAttester = errors.New("committee attestation requires an attester")
+
+// Attester collects attestations of the committee members (validators in genesis) for headers
+// signed by the sequencer.
+type Attester interface {
+	// Attest returns attestations of the header by committee members, see types.Header.Attest.
+	// It may return attestations of a subset of the committee; the sequencer retries until
+	// validators with more than 2/3 of the voting power attested the header.
+	Attest(ctx context.Context, header *types.SignedHeader) ([]types.Attestation, error)
+}
+
+// SetAttester sets the attester collecting attestations of the committee members.
+// It must be called before starting the aggregation loop.
+func (m *Manager) SetAttester(attester Attester) {
+	m.attester = attester
+}
+
+// committeeRequired returns true if headers have to carry a commit of the attester committee.
+func (m *Manager) committeeRequired() bool {
+	return m.conf.RequireCommittee || len(m.genesis.Validators) > 1
+}
+
+// hasRequiredCommit returns false if the header is signed by the sequencer only, but it has to be
+// attested by the committee. ValidateBasic accepts such headers, if the committee is not in the header.
+func (m *Manager) hasRequiredCommit(header *types.SignedHeader) bool {
+	return header.Commit != nil || !m.committeeRequired()
+}
+
+// attest builds the commit of the committee for the header signed by the sequencer. It blocks until
+// validators with more than 2/3 of the voting power attested the header, or ctx is done.
+func (m *Manager) attest(ctx context.Context, header *types.SignedHeader) error {
+	if index, _ := header.Validators.GetByAddress(header.ProposerAddress); index < 0 {
+		return types.ErrProposerNotInValSet
+	}
+	// sign bytes of attestations don't depend on the validator index, so the header signature is
+	// the attestation of the sequencer, see Header.AttestationSignBytes
+	own := types.Attestation{ValidatorAddress: header.ProposerAddress, Signature: header.Signature}
+
+	for {
+		attestations := []types.Attestation{own}
+		if m.attester != nil {
+			others, err := m.attester.Attest(ctx, header)
+			if err != nil {
+				m.logger.Error("failed to collect attestations", "height", header.Height(), "error", err)
+			}
+			attestations = append(attestations, m.validAttestations(header, others)...)
+		}
+		commit := types.NewCommit(&header.Header, header.Validators, attestations)
+		err := header.Validators.VerifyCommitLight(header.ChainID(), header.BlockID(), int64(header.Height()), commit) //nolint:gosec
+		if err == nil {
+			header.Commit = commit
+			return nil
+		}
+		if m.attester == nil {
+			return fmt.Errorf("%w: %w", ErrNoAttester, err)
+		}
+		m.logger.Info("Waiting for attestations of the committee", "height", header.Height(), "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.conf.BlockTime):
+		}
+	}
+}
+
+// validAttestations returns attestations of the header by validators other than the sequencer,
+// dropping the ones with invalid signatures, so that they don't invalidate the whole commit.
+func (m *Manager) validAttestations(header *types.SignedHeader, attestations []types.Attestation) []types.Attestation {
+	valid := make([]types.Attestation, 0, len(attestations))
+	for _, a := range attestations {
+		index, val := header.Validators.GetByAddress(a.ValidatorAddress)
+		if val == nil || bytes.Equal(val.Address, header.ProposerAddress) {
+			continue
+		}
+		if !val.PubKey.VerifySignature(header.AttestationSignBytes(val.Address, index), a.Signature) {
+			m.logger.Debug("invalid attestation", "height", header.Height(), "validator", val.Address)
+			continue
+		}
+		valid = append(valid, a)
+	}
+	return valid
+}
@@ -0,0 +1,105 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+type testAttester struct {
+	keys []cmcrypto.PrivKey
+}
+
+func (a *testAttester) Attest(_ context.Context, header *types.SignedHeader) ([]types.Attestation, error) {
+	attestations := make([]types.Attestation, 0, len(a.keys))
+	for _, key := range a.keys {
+		index, val := header.Validators.GetByAddress(key.PubKey().Address())
+		attestation, err := header.Attest(val.Address, index, key.Sign)
+		if err != nil {
+			return nil, err
+		}
+		attestations = append(attestations, attestation)
+	}
+	return attestations, nil
+}
+
+// getCommitteeManager returns a manager of the sequencer using the first key, with all keys in
+// the committee, and a header signed by the sequencer.
+func getCommitteeManager(t *testing.T, keys []cmcrypto.PrivKey) (*Manager, *types.SignedHeader) {
+	t.Helper()
+	validators := make([]*cmtypes.Validator, len(keys))
+	genesisValidators := make([]cmtypes.GenesisValidator, len(keys))
+	for i, key := range keys {
+		validators[i] = cmtypes.NewValidator(key.PubKey(), 1)
+		genesisValidators[i] = cmtypes.GenesisValidator{Address: key.PubKey().Address(), PubKey: key.PubKey(), Power: 1}
+	}
+	header := &types.SignedHeader{Header: types.GetRandomHeader(), Validators: cmtypes.NewValidatorSet(validators)}
+	header.ProposerAddress = keys[0].PubKey().Address()
+	header.ValidatorHash = header.Validators.Hash()
+	signature, err := types.GetSignature(header.Header, keys[0])
+	require.NoError(t, err)
+	header.Signature = *signature
+
+	m := &Manager{
+		conf:    config.BlockManagerConfig{BlockTime: defaultBlockTime},
+		genesis: &cmtypes.GenesisDoc{ChainID: header.ChainID(), Validators: genesisValidators},
+		logger:  test.NewLogger(t),
+	}
+	return m, header
+}
+
+func TestAttest(t *testing.T) {
+	keys := []cmcrypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	ctx := context.Background()
+
+	t.Run("no attester", func(t *testing.T) {
+		m, header := getCommitteeManager(t, keys)
+		assert.ErrorIs(t, m.attest(ctx, header), ErrNoAttester)
+		assert.Nil(t, header.Commit)
+	})
+
+	t.Run("invalid attestations are dropped", func(t *testing.T) {
+		m, header := getCommitteeManager(t, keys)
+		m.SetAttester(&testAttester{keys: keys[1:3]})
+		// attestation of the last validator signed with a key outside of the committee
+		forged, err := header.Attest(keys[3].PubKey().Address(), 3, ed25519.GenPrivKey().Sign)
+		require.NoError(t, err)
+		assert.Empty(t, m.validAttestations(header, []types.Attestation{forged}))
+
+		require.NoError(t, m.attest(ctx, header))
+		require.NotNil(t, header.Commit)
+		assert.NoError(t, header.ValidateBasic())
+	})
+
+	t.Run("waits for attestations", func(t *testing.T) {
+		m, header := getCommitteeManager(t, keys)
+		m.SetAttester(&testAttester{keys: keys[1:2]})
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, m.attest(ctx, header), context.Canceled)
+	})
+}
+
+func TestHasRequiredCommit(t *testing.T) {
+	keys := []cmcrypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+
+	m, header := getCommitteeManager(t, keys)
+	assert.False(t, m.hasRequiredCommit(header))
+	m.SetAttester(&testAttester{keys: keys[1:]})
+	require.NoError(t, m.attest(context.Background(), header))
+	assert.True(t, m.hasRequiredCommit(header))
+
+	m, header = getCommitteeManager(t, keys[:1])
+	assert.True(t, m.hasRequiredCommit(header))
+	m.conf.RequireCommittee = true
+	assert.False(t, m.hasRequiredCommit(header))
+}
@@ -0,0 +1,144 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/types"
+)
+
+var (
+	// ErrDAConflict is returned when a block applied from P2P network is contradicted by a block published on DA.
+	ErrDAConflict = errors.New("block applied from P2P network conflicts with block published on DA")
+
+	// ErrNoAppRollbacker is returned when DA conflicts have to be resolved by rollback, but no application
+	// rollbacker is set.
+	ErrNoAppRollbacker = errors.New("DA conflict policy rollback requires an application rollbacker")
+)
+
+// daConflict is used to pass header retrieved from DA that contradicts an applied block to daConflictCh.
+type daConflict struct {
+	Header   *types.SignedHeader
+	DAHeight uint64
+}
+
+// SetAppRollbacker sets the application rollbacker used to resolve conflicts between P2P and DA blocks.
+func (m *Manager) SetAppRollbacker(rollbacker AppRollbacker) {
+	m.appRollbacker = rollbacker
+}
+
+// checkDAConflict checks if the header retrieved from DA contradicts a block applied from P2P network
+// that is not yet included in DA. Headers ordered by DA are canonical, so the conflicting pair is
+// persisted as equivocation evidence and the conflict is passed to SyncLoop for resolution.
+// It returns true if the header conflicts with the applied block.
+func (m *Manager) checkDAConflict(ctx context.Context, header *types.SignedHeader, daHeight uint64) bool {
+	height := header.Height()
+	if height > m.store.Height() {
+		return false
+	}
+	stored, _, err := m.store.GetBlockData(ctx, height)
+	if err != nil {
+		m.logger.Error("failed to load block for DA conflict check", "height", height, "error", err)
+		return false
+	}
+	storedHash := stored.Hash()
+	if bytes.Equal(storedHash, header.Hash()) {
+		return false
+	}
+	if m.headerCache.isDAIncluded(storedHash.String()) || height <= m.GetDAIncludedHeight() {
+		// applied block was published on DA first, so it's canonical
+		return false
+	}
+
+	m.logger.Error("block applied from P2P network is contradicted by DA",
+		"height", height,
+		"p2pHash", storedHash.String(),
+		"daHash", header.Hash().String(),
+		"daHeight", daHeight)
+	ev, err := types.NewEquivocationEvidence(stored, header)
+	if err != nil {
+		m.logger.Error("failed to create evidence for conflicting blocks", "height", height, "error", err)
+	} else if added, err := m.saveEquivocationEvidence(ctx, ev); err != nil {
+		m.logger.Error("failed to save evidence for conflicting blocks", "height", height, "error", err)
+	} else if added {
+		m.publishEquivocationEvidence(ev)
+	}
+
+	select {
+	case <-ctx.Done():
+	case m.daConflictCh <- daConflict{header, daHeight}:
+	}
+	return true
+}
+
+// resolveDAConflict makes the header retrieved from DA canonical, according to the DA conflict policy.
+// Blocks contradicted by DA are rolled back (together with application state) and the node re-syncs
+// from DA. If rollback is not enabled, rollback fails or the header from DA can't be synced, error is
+// returned and the node should halt.
+func (m *Manager) resolveDAConflict(ctx context.Context, conflict daConflict) error {
+	header := conflict.Header
+	height := header.Height()
+	if height <= m.store.Height() {
+		stored, data, err := m.store.GetBlockData(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to load block at height %d: %w", height, err)
+		}
+		if !bytes.Equal(stored.Hash(), header.Hash()) {
+			if m.conf.DAConflictPolicy != config.DAConflictPolicyRollback {
+				return fmt.Errorf("%w: height %d, P2P block %s, DA block %s at DA height %d (conflicting headers are stored as equivocation evidence)",
+					ErrDAConflict, height, stored.Hash(), header.Hash(), conflict.DAHeight)
+			}
+			if err := m.rollback(ctx, height-1); err != nil {
+				return fmt.Errorf("%w: failed to rollback to height %d: %w", ErrDAConflict, height-1, err)
+			}
+			m.logger.Info("rolled back blocks contradicted by DA", "height", height-1)
+			// data of the removed block was received from P2P network, so it's reused only if the header
+			// from DA commits to it; otherwise the node waits for the data the header commits to
+			if types.Validate(header, data) == nil {
+				m.dataCache.setData(height, data)
+			}
+		}
+	}
+
+	if err := m.setDAIncluded(ctx, header, conflict.DAHeight); err != nil {
+		return err
+	}
+	m.headerCache.setHeader(height, header)
+	if err := m.trySyncNextBlock(ctx, conflict.DAHeight); err != nil {
+		return fmt.Errorf("failed to sync block from DA at height %d: %w", height, err)
+	}
+	m.headerCache.setSeen(header.Hash().String())
+	return nil
+}
+
+// rollback reverts blocks and state of the node, then application state, to given height.
+//
+// The store is rolled back first: if the application can't be rolled back, the node halts, and
+// application state has to be rolled back to the height of the store before restarting the node.
+func (m *Manager) rollback(ctx context.Context, height uint64) error {
+	if m.appRollbacker == nil {
+		return ErrNoAppRollbacker
+	}
+
+	m.lastStateMtx.Lock()
+	defer m.lastStateMtx.Unlock()
+	s, err := Rollback(ctx, m.store, m.genesis, height)
+	if err != nil {
+		return err
+	}
+	m.lastState = s
+	m.metrics.Height.Set(float64(s.LastBlockHeight))
+	if signed := m.signGuard.lastSignedHeight(); signed > height {
+		// the headers included in DA are synced instead, the rolled back ones are never signed again
+		m.logger.Error("headers above the rollback height were signed by this node, it refuses to sign headers up to the last signed height, which have to be synced from DA",
+			"height", height, "lastSignedHeight", signed)
+	}
+
+	if err := m.appRollbacker.Rollback(ctx, height); err != nil {
+		return fmt.Errorf("failed to rollback application, it has to be rolled back to height %d manually: %w", height, err)
+	}
+	return nil
+}
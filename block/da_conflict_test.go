@@ -0,0 +1,145 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+type mockAppRollbacker struct {
+	heights []uint64
+	err     error
+}
+
+func (r *mockAppRollbacker) Rollback(_ context.Context, height uint64) error {
+	r.heights = append(r.heights, height)
+	return r.err
+}
+
+func getDAConflictManager(t *testing.T, policy string) (*Manager, []*types.SignedHeader, *types.SignedHeader, cmcrypto.PrivKey) {
+	t.Helper()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+	headers, _, privKey := saveTestChain(t, s, 3)
+	lastState, err := s.GetState(context.Background())
+	require.NoError(t, err)
+
+	m := getEquivocationManager(t, headers[0], privKey, config.EquivocationPolicyHalt)
+	m.store = s
+	m.signGuard, err = newSignGuard(context.Background(), s)
+	require.NoError(t, err)
+	m.conf.DAConflictPolicy = policy
+	m.lastState = lastState
+	m.lastStateMtx = new(sync.RWMutex)
+	m.dataCache = NewDataCache()
+	m.daConflictCh = make(chan daConflict, 1)
+	m.metrics = NopMetrics()
+	m.executor = state.NewBlockExecutor(nil, headers[0].ChainID(), nil, nil, nil, nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+	return m, headers, getConflictingHeader(t, headers[2], privKey), privKey
+}
+
+func TestCheckDAConflict(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	m, headers, conflicting, _ := getDAConflictManager(t, config.DAConflictPolicyHalt)
+
+	// matching header is not conflicting
+	assert.False(m.checkDAConflict(ctx, headers[2], 10))
+
+	require.True(m.checkDAConflict(ctx, conflicting, 10))
+	conflict := <-m.daConflictCh
+	assert.Equal(conflicting, conflict.Header)
+	assert.Equal(uint64(10), conflict.DAHeight)
+
+	// conflicting pair is persisted, but it's not handled as equivocation
+	ev, err := m.GetEquivocationEvidence(ctx, conflicting.Height())
+	require.NoError(err)
+	assert.Equal(int64(conflicting.Height()), ev.Height())
+	assert.Len(m.EvidenceCh, 1)
+	assert.Empty(m.equivocationCh)
+
+	// block already included in DA is canonical
+	m.headerCache.setDAIncluded(headers[2].Hash().String())
+	assert.False(m.checkDAConflict(ctx, conflicting, 11))
+}
+
+func TestResolveDAConflictHalt(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	m, _, conflicting, _ := getDAConflictManager(t, config.DAConflictPolicyHalt)
+	err := m.resolveDAConflict(ctx, daConflict{conflicting, 10})
+	require.ErrorIs(err, ErrDAConflict)
+	require.Equal(uint64(3), m.store.Height())
+
+	// rollback requires application support
+	m.conf.DAConflictPolicy = config.DAConflictPolicyRollback
+	require.ErrorIs(m.CheckSetup(), ErrNoAppRollbacker)
+	require.ErrorIs(m.resolveDAConflict(ctx, daConflict{conflicting, 10}), ErrNoAppRollbacker)
+	require.Equal(uint64(3), m.store.Height())
+
+	// store is rolled back before the application, so the node halts with application state to roll back
+	m.SetAppRollbacker(&mockAppRollbacker{err: errors.New("not supported")})
+	require.NoError(m.CheckSetup())
+	err = m.resolveDAConflict(ctx, daConflict{conflicting, 10})
+	require.ErrorIs(err, ErrDAConflict)
+	require.ErrorContains(err, "rolled back to height 2 manually")
+	require.Equal(uint64(2), m.store.Height())
+}
+
+func TestResolveDAConflictRollback(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	m, headers, conflicting, _ := getDAConflictManager(t, config.DAConflictPolicyRollback)
+	rollbacker := &mockAppRollbacker{}
+	m.SetAppRollbacker(rollbacker)
+
+	// header from DA commits to the same data, but it's invalid on top of the rolled back state
+	err := m.resolveDAConflict(ctx, daConflict{conflicting, 10})
+	require.ErrorContains(err, "failed to sync block from DA at height 3")
+	assert.Equal([]uint64{2}, rollbacker.heights)
+	assert.Equal(uint64(2), m.store.Height())
+	assert.Equal(uint64(2), m.lastState.LastBlockHeight)
+	assert.Equal(headers[2].AppHash, m.lastState.AppHash)
+
+	// header from DA is canonical
+	assert.True(m.IsDAIncluded(conflicting.Hash()))
+	assert.Equal(conflicting, m.headerCache.getHeader(conflicting.Height()))
+	assert.NotNil(m.dataCache.getData(conflicting.Height()))
+}
+
+func TestResolveDAConflictRollbackOtherData(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	m, headers, _, privKey := getDAConflictManager(t, config.DAConflictPolicyRollback)
+	m.SetAppRollbacker(&mockAppRollbacker{})
+	conflicting := *headers[2]
+	conflicting.DataHash = types.GetRandomBytes(32)
+	signature, err := types.GetSignature(conflicting.Header, privKey)
+	require.NoError(err)
+	conflicting.Signature = *signature
+
+	// data received from P2P network is not reused, the node waits for the data from DA header
+	require.NoError(m.resolveDAConflict(ctx, daConflict{&conflicting, 10}))
+	assert.Equal(uint64(2), m.store.Height())
+	assert.Equal(&conflicting, m.headerCache.getHeader(conflicting.Height()))
+	assert.Nil(m.dataCache.getData(conflicting.Height()))
+}
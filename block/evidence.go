@@ -0,0 +1,176 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/types"
+)
+
+const (
+	// EquivocationEvidenceKeyPrefix is the prefix of keys used for persisting equivocation evidence in store.
+	EquivocationEvidenceKeyPrefix = "equivocation evidence"
+
+	// EquivocationHeightsKey is the key used for persisting heights of all stored equivocation evidence.
+	EquivocationHeightsKey = "equivocation heights"
+)
+
+// ErrUnexpectedSequencer is returned when evidence refers to headers not signed by the expected sequencer.
+var ErrUnexpectedSequencer = errors.New("headers are not signed by the expected sequencer")
+
+func equivocationEvidenceKey(height uint64) string {
+	return fmt.Sprintf("%s/%d", EquivocationEvidenceKeyPrefix, height)
+}
+
+// checkEquivocation compares the header with the header already known at the same height
+// (synced or cached). Conflicting headers are reported as equivocation evidence.
+// It returns true if the header conflicts with the known one.
+func (m *Manager) checkEquivocation(ctx context.Context, header *types.SignedHeader) bool {
+	height := header.Height()
+	known := m.headerCache.getHeader(height)
+	if known == nil && height <= m.store.Height() {
+		stored, _, err := m.store.GetBlockData(ctx, height)
+		if err != nil {
+			m.logger.Error("failed to load header for equivocation check", "height", height, "error", err)
+			return false
+		}
+		known = stored
+	}
+	if known == nil || bytes.Equal(known.Hash(), header.Hash()) {
+		return false
+	}
+	ev, err := types.NewEquivocationEvidence(known, header)
+	if err != nil {
+		m.logger.Debug("ignoring conflicting header", "height", height, "error", err)
+		return false
+	}
+	if err := m.BroadcastEquivocationEvidence(ctx, ev); err != nil {
+		m.logger.Error("failed to add equivocation evidence", "height", height, "error", err)
+	}
+	return true
+}
+
+// AddEquivocationEvidence verifies and persists evidence of sequencer equivocation received from
+// the network, and handles it according to the equivocation policy. The evidence is relayed by pubsub,
+// so it's not published on EvidenceCh again, see BroadcastEquivocationEvidence.
+func (m *Manager) AddEquivocationEvidence(ctx context.Context, ev *types.EquivocationEvidence) error {
+	_, err := m.addEquivocationEvidence(ctx, ev)
+	return err
+}
+
+// BroadcastEquivocationEvidence verifies and persists evidence of sequencer equivocation, handles it
+// according to the equivocation policy and publishes it on EvidenceCh, unless it's already known.
+func (m *Manager) BroadcastEquivocationEvidence(ctx context.Context, ev *types.EquivocationEvidence) error {
+	added, err := m.addEquivocationEvidence(ctx, ev)
+	if added {
+		m.publishEquivocationEvidence(ev)
+	}
+	return err
+}
+
+// addEquivocationEvidence verifies and persists the evidence, and notifies the sync goroutine.
+// It returns false if the evidence is invalid or evidence for the same height is already known.
+func (m *Manager) addEquivocationEvidence(ctx context.Context, ev *types.EquivocationEvidence) (bool, error) {
+	if err := ev.ValidateBasic(); err != nil {
+		return false, err
+	}
+	if !m.isUsingExpectedCentralizedSequencer(ev.HeaderA) || !m.isUsingExpectedCentralizedSequencer(ev.HeaderB) {
+		return false, ErrUnexpectedSequencer
+	}
+	if ev.HeaderA.ChainID() != m.genesis.ChainID {
+		return false, fmt.Errorf("evidence for chain %s, expected %s", ev.HeaderA.ChainID(), m.genesis.ChainID)
+	}
+
+	added, err := m.saveEquivocationEvidence(ctx, ev)
+	if err != nil || !added {
+		return false, err
+	}
+	select {
+	case m.equivocationCh <- ev:
+	default:
+	}
+	return true, nil
+}
+
+// saveEquivocationEvidence persists the evidence.
+// It returns false if evidence for the same height is already known.
+func (m *Manager) saveEquivocationEvidence(ctx context.Context, ev *types.EquivocationEvidence) (bool, error) {
+	height := ev.HeaderA.Height()
+	if _, err := m.GetEquivocationEvidence(ctx, height); err == nil {
+		// evidence for this height is already known
+		return false, nil
+	} else if !errors.Is(err, ds.ErrNotFound) {
+		return false, err
+	}
+	if err := m.store.SetMetadata(ctx, equivocationEvidenceKey(height), ev.Bytes()); err != nil {
+		return false, err
+	}
+	heights, err := m.store.GetMetadata(ctx, EquivocationHeightsKey)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return false, err
+	}
+	heights = binary.BigEndian.AppendUint64(heights, height)
+	if err := m.store.SetMetadata(ctx, EquivocationHeightsKey, heights); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// publishEquivocationEvidence publishes the evidence on EvidenceCh, so it can be shared with other nodes.
+func (m *Manager) publishEquivocationEvidence(ev *types.EquivocationEvidence) {
+	select {
+	case m.EvidenceCh <- ev:
+	default:
+		m.logger.Error("evidence channel is full, evidence won't be broadcasted", "height", ev.HeaderA.Height())
+	}
+}
+
+// GetEquivocationEvidence returns the equivocation evidence stored for given height.
+func (m *Manager) GetEquivocationEvidence(ctx context.Context, height uint64) (*types.EquivocationEvidence, error) {
+	bz, err := m.store.GetMetadata(ctx, equivocationEvidenceKey(height))
+	if err != nil {
+		return nil, err
+	}
+	ev := new(types.EquivocationEvidence)
+	if err := ev.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// ListEquivocationEvidence returns all stored equivocation evidence, in order of detection.
+func (m *Manager) ListEquivocationEvidence(ctx context.Context) ([]*types.EquivocationEvidence, error) {
+	heights, err := m.store.GetMetadata(ctx, EquivocationHeightsKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	evidence := make([]*types.EquivocationEvidence, 0, len(heights)/8)
+	for i := 0; i+8 <= len(heights); i += 8 {
+		ev, err := m.GetEquivocationEvidence(ctx, binary.BigEndian.Uint64(heights[i:i+8]))
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, ev)
+	}
+	return evidence, nil
+}
+
+// handleEquivocation reacts to equivocation according to the configured policy.
+// It returns true if the node should halt.
+func (m *Manager) handleEquivocation(ev *types.EquivocationEvidence) bool {
+	if m.conf.EquivocationPolicy == config.EquivocationPolicyAlert {
+		m.logger.Error("sequencer equivocation detected, continuing according to policy", "height", ev.Height(), "hash", fmt.Sprintf("%X", ev.Hash()))
+		return false
+	}
+	m.logger.Error("sequencer equivocation detected, halting node", "height", ev.Height(), "hash", fmt.Sprintf("%X", ev.Hash()))
+	return true
+}
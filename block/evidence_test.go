@@ -0,0 +1,144 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getEquivocationManager(t *testing.T, sh *types.SignedHeader, privKey cmcrypto.PrivKey, policy string) *Manager {
+	t.Helper()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	return &Manager{
+		conf:  config.BlockManagerConfig{EquivocationPolicy: policy},
+		store: store.New(kvStore),
+		genesis: &cmtypes.GenesisDoc{
+			ChainID: sh.ChainID(),
+			Validators: []cmtypes.GenesisValidator{{
+				Address: sh.ProposerAddress,
+				PubKey:  privKey.PubKey(),
+				Power:   1,
+			}},
+		},
+		headerCache:    NewHeaderCache(),
+		EvidenceCh:     make(chan *types.EquivocationEvidence, 1),
+		equivocationCh: make(chan *types.EquivocationEvidence, 1),
+		logger:         test.NewLogger(t),
+	}
+}
+
+func getConflictingHeader(t *testing.T, sh *types.SignedHeader, privKey cmcrypto.PrivKey) *types.SignedHeader {
+	t.Helper()
+	conflicting := *sh
+	conflicting.AppHash = types.GetRandomBytes(32)
+	signature, err := types.GetSignature(conflicting.Header, privKey)
+	require.NoError(t, err)
+	conflicting.Signature = *signature
+	return &conflicting
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	m := getEquivocationManager(t, header, privKey, config.EquivocationPolicyHalt)
+	require.NoError(m.store.SaveBlockData(ctx, header, data, &header.Signature))
+	m.store.SetHeight(ctx, header.Height())
+
+	// same header is not an equivocation
+	assert.False(m.checkEquivocation(ctx, header))
+	evidence, err := m.ListEquivocationEvidence(ctx)
+	require.NoError(err)
+	assert.Empty(evidence)
+
+	conflicting := getConflictingHeader(t, header, privKey)
+	require.True(m.checkEquivocation(ctx, conflicting))
+
+	stored, err := m.GetEquivocationEvidence(ctx, header.Height())
+	require.NoError(err)
+	assert.Equal(int64(header.Height()), stored.Height())
+
+	evidence, err = m.ListEquivocationEvidence(ctx)
+	require.NoError(err)
+	require.Len(evidence, 1)
+	assert.Equal(stored.Hash(), evidence[0].Hash())
+
+	select {
+	case ev := <-m.EvidenceCh:
+		assert.Equal(stored.Hash(), ev.Hash())
+	default:
+		t.Fatal("evidence not published")
+	}
+	select {
+	case ev := <-m.equivocationCh:
+		assert.True(m.handleEquivocation(ev))
+	default:
+		t.Fatal("equivocation not reported")
+	}
+
+	// evidence for the same height is persisted and published only once
+	require.NoError(m.BroadcastEquivocationEvidence(ctx, stored))
+	evidence, err = m.ListEquivocationEvidence(ctx)
+	require.NoError(err)
+	assert.Len(evidence, 1)
+	assert.Empty(m.EvidenceCh)
+}
+
+func TestAddEquivocationEvidenceFromNetwork(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	header, privKey, err := types.GetRandomSignedHeader()
+	require.NoError(err)
+	ev, err := types.NewEquivocationEvidence(header, getConflictingHeader(t, header, privKey))
+	require.NoError(err)
+	m := getEquivocationManager(t, header, privKey, config.EquivocationPolicyAlert)
+
+	require.NoError(m.AddEquivocationEvidence(ctx, ev))
+	_, err = m.GetEquivocationEvidence(ctx, header.Height())
+	require.NoError(err)
+	assert.Len(m.equivocationCh, 1)
+	// evidence received from the network is relayed by pubsub, not published again
+	assert.Empty(m.EvidenceCh)
+}
+
+func TestCheckEquivocationCachedHeader(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	header, privKey, err := types.GetRandomSignedHeader()
+	require.NoError(err)
+	m := getEquivocationManager(t, header, privKey, config.EquivocationPolicyAlert)
+	m.headerCache.setHeader(header.Height(), header)
+
+	require.True(m.checkEquivocation(ctx, getConflictingHeader(t, header, privKey)))
+	ev := <-m.equivocationCh
+	require.False(m.handleEquivocation(ev))
+}
+
+func TestAddEquivocationEvidenceUnexpectedSequencer(t *testing.T) {
+	require := require.New(t)
+
+	header, privKey, err := types.GetRandomSignedHeader()
+	require.NoError(err)
+	ev, err := types.NewEquivocationEvidence(header, getConflictingHeader(t, header, privKey))
+	require.NoError(err)
+
+	other, otherKey, err := types.GetRandomSignedHeader()
+	require.NoError(err)
+	m := getEquivocationManager(t, other, otherKey, config.EquivocationPolicyHalt)
+	require.ErrorIs(m.AddEquivocationEvidence(context.Background(), ev), ErrUnexpectedSequencer)
+}
@@ -0,0 +1,83 @@
+//go:build unix
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FileLease is a lease held as an exclusive lock (flock) on a file.
+//
+// The lock is released by the operating system when the holding process exits, so a standby
+// aggregator on the same host acquires it as soon as the active one stops. Locks on network file
+// systems are not reliable, so FileLease shouldn't be used across hosts.
+type FileLease struct {
+	path string
+
+	mtx  sync.Mutex
+	file *os.File
+}
+
+var _ Lease = &FileLease{}
+
+// NewFileLease returns a lease locking the file at path. The file is created if it doesn't exist.
+func NewFileLease(path string) (*FileLease, error) {
+	return &FileLease{path: path}, nil
+}
+
+// Acquire blocks until the file is locked or ctx is done.
+func (l *FileLease) Acquire(ctx context.Context) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.file != nil {
+		return nil
+	}
+
+	file, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0o600) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to open lease file: %w", err)
+	}
+	for {
+		err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			l.file = file
+			return nil
+		}
+		if !errors.Is(err, syscall.EWOULDBLOCK) {
+			_ = file.Close()
+			return fmt.Errorf("failed to lock lease file: %w", err)
+		}
+		select {
+		case <-ctx.Done():
+			_ = file.Close()
+			return ctx.Err()
+		case <-time.After(leaseRetryInterval):
+		}
+	}
+}
+
+// Held returns true if the file is locked.
+func (l *FileLease) Held() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.file != nil
+}
+
+// Release unlocks the file.
+func (l *FileLease) Release() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	// closing the file releases the lock
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
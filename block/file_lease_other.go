@@ -0,0 +1,33 @@
+//go:build !unix
+
+package block
+
+import (
+	"context"
+	"errors"
+)
+
+// FileLease is a lease held as an exclusive lock on a file. It's supported on Unix systems only.
+type FileLease struct{}
+
+var _ Lease = &FileLease{}
+
+// NewFileLease returns an error, as file leases are supported on Unix systems only.
+func NewFileLease(string) (*FileLease, error) {
+	return nil, errors.New("file lease is supported on Unix systems only")
+}
+
+// Acquire is not supported.
+func (*FileLease) Acquire(context.Context) error {
+	return errors.New("file lease is supported on Unix systems only")
+}
+
+// Held returns false.
+func (*FileLease) Held() bool {
+	return false
+}
+
+// Release is a no-op.
+func (*FileLease) Release() error {
+	return nil
+}
@@ -0,0 +1,46 @@
+//go:build unix
+
+package block
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLease(t *testing.T) {
+	leaseRetryInterval = 10 * time.Millisecond
+	path := filepath.Join(t.TempDir(), "lease")
+
+	primary, err := NewFileLease(path)
+	require.NoError(t, err)
+	standby, err := NewFileLease(path)
+	require.NoError(t, err)
+
+	require.NoError(t, primary.Acquire(context.Background()))
+	assert.True(t, primary.Held())
+
+	// the lease is held by the primary
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, standby.Acquire(ctx), context.DeadlineExceeded)
+	assert.False(t, standby.Held())
+
+	// the standby takes over once the lease is released
+	acquired := make(chan error)
+	go func() { acquired <- standby.Acquire(context.Background()) }()
+	require.NoError(t, primary.Release())
+	assert.False(t, primary.Held())
+	select {
+	case err := <-acquired:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("standby didn't acquire the lease")
+	}
+	assert.True(t, standby.Held())
+	require.NoError(t, standby.Release())
+}
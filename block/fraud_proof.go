@@ -0,0 +1,154 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/types"
+)
+
+const (
+	// FraudProofKeyPrefix is the prefix of keys used for persisting fraud proofs in store.
+	FraudProofKeyPrefix = "fraud proof"
+
+	// FraudProofHeightsKey is the key used for persisting heights of all stored fraud proofs.
+	FraudProofHeightsKey = "fraud proof heights"
+)
+
+// ErrFraudProofNotVerifiable is returned when a fraud proof received from the network can't be
+// verified, because no TxReplayer is set.
+var ErrFraudProofNotVerifiable = errors.New("fraud proofs can't be verified without a transaction replayer")
+
+func fraudProofKey(height uint64) string {
+	return fmt.Sprintf("%s/%d", FraudProofKeyPrefix, height)
+}
+
+// SetTxReplayer sets the replayer used to verify fraud proofs received from the network.
+// It must be called before the node is started.
+func (m *Manager) SetTxReplayer(replayer state.TxReplayer) {
+	m.txReplayer = replayer
+}
+
+// reportFraud persists the fraud proof generated while syncing and publishes it on FraudProofCh,
+// so it can be shared with other nodes.
+func (m *Manager) reportFraud(ctx context.Context, proof *types.FraudProof) {
+	height := proof.SignedHeader.Height()
+	m.logger.Error("fraud detected", "height", height, "txIndex", proof.TxIndex)
+	added, err := m.saveFraudProof(ctx, proof)
+	if err != nil {
+		m.logger.Error("failed to save fraud proof", "height", height, "error", err)
+	}
+	if !added {
+		return
+	}
+	select {
+	case m.FraudProofCh <- proof:
+	default:
+		m.logger.Error("fraud proof channel is full, fraud proof won't be broadcasted", "height", height)
+	}
+}
+
+// stopOnFraud stops the node like stopOnAppError when the block above given height doesn't match
+// its intermediate state roots. The block is not applied, but FinalizeBlock was already called, so
+// the application is left holding an uncommitted FinalizeBlock: it has to be restarted before the
+// node continues from the store.
+func (m *Manager) stopOnFraud(height uint64, err error) error {
+	return m.stopOnAppError(height, err)
+}
+
+// AddFraudProof verifies and persists a fraud proof received from the network, and stops syncing
+// of the fraudulent chain. It returns an error if the proof is invalid or already known.
+func (m *Manager) AddFraudProof(ctx context.Context, proof *types.FraudProof) error {
+	if m.txReplayer == nil {
+		return ErrFraudProofNotVerifiable
+	}
+	if err := proof.ValidateBasic(); err != nil {
+		return err
+	}
+	if !m.isUsingExpectedCentralizedSequencer(proof.SignedHeader) {
+		return ErrUnexpectedSequencer
+	}
+	if proof.SignedHeader.ChainID() != m.genesis.ChainID {
+		return fmt.Errorf("fraud proof for chain %s, expected %s", proof.SignedHeader.ChainID(), m.genesis.ChainID)
+	}
+	if _, err := m.GetFraudProof(ctx, proof.SignedHeader.Height()); err == nil {
+		return errors.New("fraud proof already known")
+	}
+	if err := state.VerifyFraudProof(ctx, proof, m.txReplayer); err != nil {
+		return err
+	}
+	if _, err := m.saveFraudProof(ctx, proof); err != nil {
+		return err
+	}
+	m.logger.Error("fraud proof received", "height", proof.SignedHeader.Height(), "txIndex", proof.TxIndex)
+	select {
+	case m.fraudCh <- proof:
+	default:
+	}
+	return nil
+}
+
+// saveFraudProof persists the fraud proof. It returns false if a proof for the same height is
+// already known.
+func (m *Manager) saveFraudProof(ctx context.Context, proof *types.FraudProof) (bool, error) {
+	height := proof.SignedHeader.Height()
+	if _, err := m.GetFraudProof(ctx, height); err == nil {
+		return false, nil
+	} else if !errors.Is(err, ds.ErrNotFound) {
+		return false, err
+	}
+	bz, err := proof.MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	if err := m.store.SetMetadata(ctx, fraudProofKey(height), bz); err != nil {
+		return false, err
+	}
+	heights, err := m.store.GetMetadata(ctx, FraudProofHeightsKey)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return false, err
+	}
+	heights = binary.BigEndian.AppendUint64(heights, height)
+	if err := m.store.SetMetadata(ctx, FraudProofHeightsKey, heights); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetFraudProof returns the fraud proof generated for the block at given height.
+func (m *Manager) GetFraudProof(ctx context.Context, height uint64) (*types.FraudProof, error) {
+	bz, err := m.store.GetMetadata(ctx, fraudProofKey(height))
+	if err != nil {
+		return nil, err
+	}
+	proof := new(types.FraudProof)
+	if err := proof.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// ListFraudProofs returns all stored fraud proofs, in order of detection.
+func (m *Manager) ListFraudProofs(ctx context.Context) ([]*types.FraudProof, error) {
+	heights, err := m.store.GetMetadata(ctx, FraudProofHeightsKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	proofs := make([]*types.FraudProof, 0, len(heights)/8)
+	for i := 0; i+8 <= len(heights); i += 8 {
+		proof, err := m.GetFraudProof(ctx, binary.BigEndian.Uint64(heights[i:i+8]))
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs, nil
+}
@@ -0,0 +1,100 @@
+package block
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/types"
+)
+
+type testTxReplayer struct {
+	roots map[string][]byte
+}
+
+func (r *testTxReplayer) ReplayTx(_ context.Context, _ uint64, _ []byte, tx types.Tx) (*abci.ExecTxResult, error) {
+	return &abci.ExecTxResult{Events: []abci.Event{{
+		Type:       state.ISREventType,
+		Attributes: []abci.EventAttribute{{Key: state.ISREventRootKey, Value: hex.EncodeToString(r.roots[string(tx)])}},
+	}}}, nil
+}
+
+// getFraudProof returns a proof that the sequencer committed to an invalid state root after the
+// first transaction of a block, and a replayer executing its transactions honestly.
+func getFraudProof(t *testing.T) (*types.FraudProof, cmcrypto.PrivKey, *testTxReplayer) {
+	t.Helper()
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 2, NTxs: 2})
+	replayer := &testTxReplayer{roots: make(map[string][]byte)}
+	roots := [][]byte{header.AppHash, types.GetRandomBytes(32), types.GetRandomBytes(32)}
+	for i, tx := range data.Txs {
+		replayer.roots[string(tx)] = roots[i+1]
+	}
+	roots[1] = types.GetRandomBytes(32)
+	data.IntermediateStateRoots = types.IntermediateStateRoots{RawRootsList: roots}
+	header.DataHash = (&types.Data{Txs: data.Txs, IntermediateStateRoots: data.IntermediateStateRoots}).Hash()
+	signature, err := types.GetSignature(header.Header, privKey)
+	require.NoError(t, err)
+	header.Signature = *signature
+	return &types.FraudProof{SignedHeader: header, Data: data, TxIndex: 0}, privKey, replayer
+}
+
+func TestReportFraud(t *testing.T) {
+	ctx := context.Background()
+	proof, privKey, _ := getFraudProof(t)
+	m := getEquivocationManager(t, proof.SignedHeader, privKey, "")
+	m.FraudProofCh = make(chan *types.FraudProof, 2)
+
+	m.reportFraud(ctx, proof)
+	m.reportFraud(ctx, proof)
+	// the proof is published once
+	assert.Len(t, m.FraudProofCh, 1)
+
+	proofs, err := m.ListFraudProofs(ctx)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	assert.Equal(t, proof.SignedHeader.Hash(), proofs[0].SignedHeader.Hash())
+}
+
+func TestAddFraudProof(t *testing.T) {
+	ctx := context.Background()
+	proof, privKey, replayer := getFraudProof(t)
+	m := getEquivocationManager(t, proof.SignedHeader, privKey, "")
+	m.FraudProofCh = make(chan *types.FraudProof, 1)
+	m.fraudCh = make(chan *types.FraudProof, 1)
+
+	assert.ErrorIs(t, m.AddFraudProof(ctx, proof), ErrFraudProofNotVerifiable)
+
+	m.SetTxReplayer(replayer)
+	honest := *proof
+	honest.TxIndex = 1
+	assert.ErrorIs(t, m.AddFraudProof(ctx, &honest), state.ErrFraudProofInvalid)
+
+	require.NoError(t, m.AddFraudProof(ctx, proof))
+	// proofs received from the network are relayed by pubsub, not published again
+	assert.Empty(t, m.FraudProofCh)
+	assert.Len(t, m.fraudCh, 1)
+	stored, err := m.GetFraudProof(ctx, proof.SignedHeader.Height())
+	require.NoError(t, err)
+	assert.Equal(t, proof.TxIndex, stored.TxIndex)
+
+	assert.Error(t, m.AddFraudProof(ctx, proof))
+}
+
+func TestStopOnFraud(t *testing.T) {
+	m, _ := getHaltManager(t, config.BlockManagerConfig{})
+	proof, _, _ := getFraudProof(t)
+	err := m.stopOnFraud(1, &state.FraudError{Proof: proof})
+	// fraud is not a planned halt
+	assert.NotErrorIs(t, err, ErrHalted)
+	assert.ErrorIs(t, err, ErrAppFailed)
+	assert.ErrorIs(t, err, state.ErrISRMismatch)
+	assert.Equal(t, err, m.HaltReason())
+	assert.True(t, m.isHalted())
+}
@@ -0,0 +1,200 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+const (
+	// HaltEventType is the type of the FinalizeBlock event by which the application schedules a
+	// coordinated halt of the chain, e.g. for an upgrade.
+	HaltEventType = "halt"
+
+	// HaltEventHeightKey is the attribute of the halt event holding the height of the last block
+	// before the halt. Without it, the chain halts after the block emitting the event.
+	HaltEventHeightKey = "height"
+
+	// HaltHeightKey is the key used for persisting the halt height scheduled by the application in store.
+	HaltHeightKey = "halt height"
+)
+
+var (
+	// ErrHalted is returned when the node stopped producing or syncing blocks at the halt height.
+	ErrHalted = errors.New("node halted")
+
+	// ErrHaltHeight is the reason of the halt when the configured halt height is reached.
+	ErrHaltHeight = errors.New("halt height reached")
+
+	// ErrHaltTime is the reason of the halt when the configured halt time is reached.
+	ErrHaltTime = errors.New("halt time reached")
+
+	// ErrAppHalt is the reason of the halt when the halt height scheduled by the application is reached.
+	ErrAppHalt = errors.New("halt requested by the application")
+
+	// ErrAppFailed is returned when the node stopped because the application failed to execute a
+	// block, or the results don't match the intermediate state roots of the block. Unlike ErrHalted,
+	// it's not a planned halt: the failure has to be investigated before restarting the node.
+	ErrAppFailed = errors.New("application failed to execute block")
+)
+
+// Halted returns a channel closed when the node halted. Aggregators close it after the blocks
+// produced before the halt are submitted to DA.
+func (m *Manager) Halted() <-chan struct{} {
+	return m.haltedCh
+}
+
+// HaltReason returns the reason of the halt, wrapping ErrHalted (or ErrAppFailed, if the node stopped
+// on an application error), or nil if the node didn't halt.
+func (m *Manager) HaltReason() error {
+	m.haltMtx.Lock()
+	defer m.haltMtx.Unlock()
+	return m.haltReason
+}
+
+// loadAppHaltHeight loads the halt height scheduled by the application. A halt height that was
+// already reached is ignored, so that a new binary can continue from the store.
+func (m *Manager) loadAppHaltHeight(ctx context.Context) error {
+	raw, err := m.store.GetMetadata(ctx, HaltHeightKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	height, err := strconv.ParseUint(string(raw), 10, 64)
+	if err != nil {
+		return err
+	}
+	if height > m.store.Height() {
+		m.appHaltHeight.Store(height)
+	}
+	return nil
+}
+
+// scheduleAppHalt records the halt height requested by the application in the events of the
+// block at given height.
+func (m *Manager) scheduleAppHalt(ctx context.Context, height uint64, resp *abci.ResponseFinalizeBlock) {
+	for _, event := range resp.Events {
+		if event.Type != HaltEventType {
+			continue
+		}
+		haltHeight := height
+		for _, attr := range event.Attributes {
+			if attr.Key != HaltEventHeightKey {
+				continue
+			}
+			h, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				m.logger.Error("invalid halt height requested by the application", "height", attr.Value, "error", err)
+				continue
+			}
+			// the chain can't halt in the past
+			haltHeight = max(h, height)
+		}
+		if current := m.appHaltHeight.Load(); current != 0 && current <= haltHeight {
+			continue
+		}
+		m.appHaltHeight.Store(haltHeight)
+		m.logger.Info("Application scheduled halt", "haltHeight", haltHeight)
+		if err := m.store.SetMetadata(ctx, HaltHeightKey, []byte(strconv.FormatUint(haltHeight, 10))); err != nil {
+			m.logger.Error("failed to store halt height", "error", err)
+		}
+	}
+}
+
+// checkHalt returns an error wrapping ErrHalted if the node has to halt after the block with
+// given header.
+func (m *Manager) checkHalt(header *types.SignedHeader) error {
+	height := header.Height()
+	var reason error
+	switch appHaltHeight := m.appHaltHeight.Load(); {
+	case m.conf.HaltHeight != 0 && height >= m.conf.HaltHeight:
+		reason = ErrHaltHeight
+	case appHaltHeight != 0 && height >= appHaltHeight:
+		reason = ErrAppHalt
+	case m.conf.HaltTime != 0 && !header.Time().Before(time.Unix(int64(m.conf.HaltTime), 0)): //nolint:gosec
+		reason = ErrHaltTime
+	default:
+		return nil
+	}
+	return fmt.Errorf("%w at height %d: %w", ErrHalted, height, reason)
+}
+
+// stopOnAppError stops the node like a halt when the application fails to execute the block above
+// given height, with a reason wrapping ErrAppFailed instead of ErrHalted. The block is not applied,
+// so that the node can continue from the store once the failure is fixed.
+func (m *Manager) stopOnAppError(height uint64, err error) error {
+	err = fmt.Errorf("%w at height %d: %w", ErrAppFailed, height, err)
+	m.halt(err)
+	return err
+}
+
+// checkHaltOnStart halts the node if it has to halt after the last stored block.
+func (m *Manager) checkHaltOnStart(ctx context.Context) bool {
+	height := m.store.Height()
+	if height < uint64(m.genesis.InitialHeight) { //nolint:gosec
+		return false
+	}
+	header, _, err := m.store.GetBlockData(ctx, height)
+	if err != nil {
+		m.logger.Error("failed to load last block for halt check", "height", height, "error", err)
+		return false
+	}
+	if err := m.checkHalt(header); err != nil {
+		m.halt(err)
+		return true
+	}
+	return false
+}
+
+// halt stops block production and syncing. Only the first reason is recorded.
+func (m *Manager) halt(reason error) {
+	m.haltMtx.Lock()
+	defer m.haltMtx.Unlock()
+	if m.haltReason != nil {
+		return
+	}
+	m.logger.Info("Halting node", "reason", reason)
+	m.haltReason = reason
+	close(m.haltCh)
+}
+
+// isHalted returns true if the node halted.
+func (m *Manager) isHalted() bool {
+	select {
+	case <-m.haltCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// setHalted closes the channel returned by Halted, once the node stopped.
+func (m *Manager) setHalted() {
+	m.haltedOnce.Do(func() { close(m.haltedCh) })
+}
+
+// flushPendingHeaders submits all pending headers to DA, retrying until it succeeds or ctx is done.
+func (m *Manager) flushPendingHeaders(ctx context.Context) {
+	for !m.pendingHeaders.isEmpty() {
+		m.logger.Info("Submitting pending headers to DA before halt", "count", m.pendingHeaders.numPendingHeaders())
+		err := m.submitHeadersToDA(ctx)
+		if err == nil {
+			continue
+		}
+		m.logger.Error("error while submitting block to DA", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.conf.DABlockTime):
+		}
+	}
+}
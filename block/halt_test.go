@@ -0,0 +1,174 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func getHaltManager(t *testing.T, conf config.BlockManagerConfig) (*Manager, []*types.SignedHeader) {
+	t.Helper()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+	headers, _, _ := saveTestChain(t, s, 3)
+
+	return &Manager{
+		store:        s,
+		conf:         conf,
+		genesis:      &cmtypes.GenesisDoc{InitialHeight: 1},
+		lastStateMtx: new(sync.RWMutex),
+		logger:       test.NewLogger(t),
+		haltCh:       make(chan struct{}),
+		haltedCh:     make(chan struct{}),
+	}, headers
+}
+
+func TestCheckHalt(t *testing.T) {
+	_, headers := getHaltManager(t, config.BlockManagerConfig{})
+	header := headers[1]
+
+	cases := []struct {
+		name          string
+		conf          config.BlockManagerConfig
+		appHaltHeight uint64
+		expected      error
+	}{
+		{"no halt", config.BlockManagerConfig{}, 0, nil},
+		{"below halt height", config.BlockManagerConfig{HaltHeight: 3}, 0, nil},
+		{"halt height", config.BlockManagerConfig{HaltHeight: 2}, 0, ErrHaltHeight},
+		{"above halt height", config.BlockManagerConfig{HaltHeight: 1}, 0, ErrHaltHeight},
+		{"below app halt height", config.BlockManagerConfig{}, 3, nil},
+		{"app halt height", config.BlockManagerConfig{}, 2, ErrAppHalt},
+		{"before halt time", config.BlockManagerConfig{HaltTime: uint64(header.Time().Add(time.Second).Unix())}, 0, nil},   //nolint:gosec
+		{"halt time", config.BlockManagerConfig{HaltTime: uint64(header.Time().Add(-time.Second).Unix())}, 0, ErrHaltTime}, //nolint:gosec
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, _ := getHaltManager(t, c.conf)
+			m.appHaltHeight.Store(c.appHaltHeight)
+			err := m.checkHalt(header)
+			if c.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrHalted)
+			assert.ErrorIs(t, err, c.expected)
+		})
+	}
+}
+
+func TestScheduleAppHalt(t *testing.T) {
+	ctx := context.Background()
+	haltEvent := func(attrs ...abci.EventAttribute) *abci.ResponseFinalizeBlock {
+		return &abci.ResponseFinalizeBlock{Events: []abci.Event{
+			{Type: "transfer"},
+			{Type: HaltEventType, Attributes: attrs},
+		}}
+	}
+
+	cases := []struct {
+		name     string
+		resp     *abci.ResponseFinalizeBlock
+		expected uint64
+	}{
+		{"no halt event", &abci.ResponseFinalizeBlock{Events: []abci.Event{{Type: "transfer"}}}, 0},
+		{"halt after block", haltEvent(), 4},
+		{"halt height", haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "10"}), 10},
+		{"halt height in the past", haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "2"}), 4},
+		{"invalid halt height", haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "soon"}), 4},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, _ := getHaltManager(t, config.BlockManagerConfig{})
+			m.scheduleAppHalt(ctx, 4, c.resp)
+			assert.Equal(t, c.expected, m.appHaltHeight.Load())
+
+			// the halt height is persisted
+			m.appHaltHeight.Store(0)
+			require.NoError(t, m.loadAppHaltHeight(ctx))
+			assert.Equal(t, c.expected, m.appHaltHeight.Load())
+		})
+	}
+
+	t.Run("earlier halt wins", func(t *testing.T) {
+		m, _ := getHaltManager(t, config.BlockManagerConfig{})
+		m.scheduleAppHalt(ctx, 4, haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "10"}))
+		m.scheduleAppHalt(ctx, 5, haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "20"}))
+		assert.Equal(t, uint64(10), m.appHaltHeight.Load())
+		m.scheduleAppHalt(ctx, 6, haltEvent(abci.EventAttribute{Key: HaltEventHeightKey, Value: "8"}))
+		assert.Equal(t, uint64(8), m.appHaltHeight.Load())
+	})
+}
+
+func TestLoadAppHaltHeight(t *testing.T) {
+	ctx := context.Background()
+	m, _ := getHaltManager(t, config.BlockManagerConfig{})
+	require.NoError(t, m.loadAppHaltHeight(ctx))
+	assert.Zero(t, m.appHaltHeight.Load())
+
+	// a halt height that was reached is ignored, so that a new binary can continue
+	require.NoError(t, m.store.SetMetadata(ctx, HaltHeightKey, []byte("3")))
+	require.NoError(t, m.loadAppHaltHeight(ctx))
+	assert.Zero(t, m.appHaltHeight.Load())
+
+	require.NoError(t, m.store.SetMetadata(ctx, HaltHeightKey, []byte("4")))
+	require.NoError(t, m.loadAppHaltHeight(ctx))
+	assert.Equal(t, uint64(4), m.appHaltHeight.Load())
+}
+
+func TestHalt(t *testing.T) {
+	m, _ := getHaltManager(t, config.BlockManagerConfig{})
+	assert.False(t, m.isHalted())
+	assert.NoError(t, m.HaltReason())
+
+	reason := errors.New("first")
+	m.halt(reason)
+	m.halt(errors.New("second"))
+	assert.True(t, m.isHalted())
+	assert.Equal(t, reason, m.HaltReason())
+
+	select {
+	case <-m.Halted():
+		t.Fatal("node halted before it stopped")
+	default:
+	}
+	m.setHalted()
+	m.setHalted()
+	<-m.Halted()
+}
+
+func TestCheckHaltOnStart(t *testing.T) {
+	ctx := context.Background()
+
+	m, _ := getHaltManager(t, config.BlockManagerConfig{HaltHeight: 4})
+	assert.False(t, m.checkHaltOnStart(ctx))
+	assert.False(t, m.isHalted())
+
+	m, _ = getHaltManager(t, config.BlockManagerConfig{HaltHeight: 3})
+	assert.True(t, m.checkHaltOnStart(ctx))
+	assert.ErrorIs(t, m.HaltReason(), ErrHaltHeight)
+}
+
+func TestStopOnAppError(t *testing.T) {
+	m, _ := getHaltManager(t, config.BlockManagerConfig{})
+	appErr := errors.New("upgrade needed")
+	err := m.stopOnAppError(3, appErr)
+	// application errors are not planned halts
+	assert.NotErrorIs(t, err, ErrHalted)
+	assert.ErrorIs(t, err, ErrAppFailed)
+	assert.ErrorIs(t, err, appErr)
+	assert.Equal(t, err, m.HaltReason())
+}
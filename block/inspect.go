@@ -0,0 +1,82 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// MetadataEntry is a value saved in the store with SetMetadata.
+type MetadataEntry struct {
+	Key string `json:"key"`
+	// Value is decoded for metadata saved by the block manager, and hex encoded otherwise.
+	Value string `json:"value"`
+}
+
+// InspectMetadata returns all metadata saved in the store, in order of keys.
+func InspectMetadata(ctx context.Context, s *store.DefaultStore) ([]MetadataEntry, error) {
+	keys, err := s.MetadataKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]MetadataEntry, 0, len(keys))
+	for _, key := range keys {
+		value, err := s.GetMetadata(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, MetadataEntry{Key: key, Value: decodeMetadata(key, value)})
+	}
+	return entries, nil
+}
+
+func decodeMetadata(key string, value []byte) string {
+	switch {
+	case key == DAIncludedHeightKey && len(value) == 8:
+		return strconv.FormatUint(binary.BigEndian.Uint64(value), 10)
+	case key == LastSubmittedHeightKey:
+		return string(value)
+	case key == EquivocationHeightsKey && len(value)%8 == 0:
+		heights := make([]string, 0, len(value)/8)
+		for i := 0; i < len(value); i += 8 {
+			heights = append(heights, strconv.FormatUint(binary.BigEndian.Uint64(value[i:i+8]), 10))
+		}
+		return strings.Join(heights, ",")
+	case strings.HasPrefix(key, FraudProofKeyPrefix+"/") || strings.HasPrefix(key, EquivocationEvidenceKeyPrefix+"/"):
+		return fmt.Sprintf("%d bytes", len(value))
+	}
+	return fmt.Sprintf("%X", value)
+}
+
+// InspectPendingHeaders returns the range of headers saved in the store, but not submitted to DA yet.
+func InspectPendingHeaders(ctx context.Context, s store.Store) (types.ResultPendingHeaders, error) {
+	st, err := s.GetState(ctx)
+	if err != nil {
+		return types.ResultPendingHeaders{}, fmt.Errorf("failed to load state: %w", err)
+	}
+	var lastSubmitted uint64
+	raw, err := s.GetMetadata(ctx, LastSubmittedHeightKey)
+	if err == nil {
+		if lastSubmitted, err = strconv.ParseUint(string(raw), 10, 64); err != nil {
+			return types.ResultPendingHeaders{}, fmt.Errorf("invalid last submitted height: %w", err)
+		}
+	} else if !errors.Is(err, ds.ErrNotFound) {
+		return types.ResultPendingHeaders{}, err
+	}
+	if st.LastBlockHeight <= lastSubmitted {
+		return types.ResultPendingHeaders{}, nil
+	}
+	return types.ResultPendingHeaders{
+		Count:      st.LastBlockHeight - lastSubmitted,
+		FromHeight: lastSubmitted + 1,
+		ToHeight:   st.LastBlockHeight,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestInspectMetadata(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore).(*store.DefaultStore)
+
+	require.NoError(s.SetMetadata(ctx, DAIncludedHeightKey, binary.BigEndian.AppendUint64(nil, 7)))
+	require.NoError(s.SetMetadata(ctx, LastSubmittedHeightKey, []byte("5")))
+	require.NoError(s.SetMetadata(ctx, EquivocationHeightsKey, binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, 3), 4)))
+	require.NoError(s.SetMetadata(ctx, fraudProofKey(2), []byte{1, 2, 3}))
+	require.NoError(s.SetMetadata(ctx, "custom", []byte{0xab, 0xcd}))
+
+	entries, err := InspectMetadata(ctx, s)
+	require.NoError(err)
+	assert.Equal(t, []MetadataEntry{
+		{Key: "custom", Value: "ABCD"},
+		{Key: DAIncludedHeightKey, Value: "7"},
+		{Key: EquivocationHeightsKey, Value: "3,4"},
+		{Key: fraudProofKey(2), Value: "3 bytes"},
+		{Key: LastSubmittedHeightKey, Value: "5"},
+	}, entries)
+}
+
+func TestInspectPendingHeaders(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore)
+	saveTestChain(t, s, 5)
+
+	pending, err := InspectPendingHeaders(ctx, s)
+	require.NoError(err)
+	assert.Equal(types.ResultPendingHeaders{Count: 5, FromHeight: 1, ToHeight: 5}, pending)
+
+	require.NoError(s.SetMetadata(ctx, LastSubmittedHeightKey, []byte("3")))
+	pending, err = InspectPendingHeaders(ctx, s)
+	require.NoError(err)
+	assert.Equal(types.ResultPendingHeaders{Count: 2, FromHeight: 4, ToHeight: 5}, pending)
+
+	require.NoError(s.SetMetadata(ctx, LastSubmittedHeightKey, []byte("5")))
+	pending, err = InspectPendingHeaders(ctx, s)
+	require.NoError(err)
+	assert.Zero(pending)
+}
@@ -0,0 +1,115 @@
+package block
+
+import (
+	"time"
+
+	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/types"
+)
+
+// GetPendingHeaders returns the number of headers not yet submitted to DA and their height range.
+func (m *Manager) GetPendingHeaders() types.ResultPendingHeaders {
+	if m.pendingHeaders == nil {
+		return types.ResultPendingHeaders{}
+	}
+	lastSubmitted := m.pendingHeaders.getLastSubmittedHeight()
+	height := m.store.Height()
+	if height <= lastSubmitted {
+		return types.ResultPendingHeaders{}
+	}
+	return types.ResultPendingHeaders{
+		Count:      height - lastSubmitted,
+		FromHeight: lastSubmitted + 1,
+		ToHeight:   height,
+	}
+}
+
+// GetDAStatus returns the status of DA retrieval and submission.
+func (m *Manager) GetDAStatus() types.ResultDAStatus {
+	status := types.ResultDAStatus{
+		DAHeight:         m.GetDAHeight(),
+		DAIncludedHeight: m.GetDAIncludedHeight(),
+	}
+	if m.dalc != nil {
+		status.GasPrice = m.dalc.GasPrice
+		status.GasMultiplier = m.dalc.GasMultiplier
+	}
+
+	m.lastDASubmissionMu.RLock()
+	defer m.lastDASubmissionMu.RUnlock()
+	if m.lastDASubmission != nil {
+		submission := *m.lastDASubmission
+		status.LastSubmission = &submission
+		status.GasPrice = submission.GasPrice
+	}
+	return status
+}
+
+// GetBatchQueueDepth returns the number of batches waiting for block production.
+func (m *Manager) GetBatchQueueDepth() int {
+	if m.bq == nil {
+		return 0
+	}
+	return m.bq.Len()
+}
+
+// GetSyncStatus returns heights of the block store, P2P sync stores and DA.
+func (m *Manager) GetSyncStatus() types.ResultSyncStatus {
+	status := types.ResultSyncStatus{
+		Height:           m.store.Height(),
+		DAHeight:         m.GetDAHeight(),
+		DAIncludedHeight: m.GetDAIncludedHeight(),
+	}
+	if m.headerStore != nil {
+		status.HeaderStoreHeight = m.headerStore.Height()
+	}
+	if m.dataStore != nil {
+		status.DataStoreHeight = m.dataStore.Height()
+	}
+	status.DABlockHeight = m.daBlockHeight.Load()
+
+	status.TargetHeight = max(status.Height, status.HeaderStoreHeight, status.DataStoreHeight, status.DABlockHeight)
+	status.BlocksBehind = status.TargetHeight - status.Height
+	// proposer produces blocks, so it's never catching up, unless it's a standby
+	status.CatchingUp = (!m.isProposer || m.standby.Load()) && status.BlocksBehind > m.conf.CatchingUpThreshold
+	return status
+}
+
+// GetLastBlockTime returns the time of the latest block produced or synced by the node.
+func (m *Manager) GetLastBlockTime() time.Time {
+	return m.getLastBlockTime()
+}
+
+// updateSyncMetrics reports sync status in metrics.
+func (m *Manager) updateSyncMetrics() {
+	status := m.GetSyncStatus()
+	catchingUp := 0.0
+	if status.CatchingUp {
+		catchingUp = 1
+	}
+	m.metrics.CatchingUp.Set(catchingUp)
+	m.metrics.BlocksBehind.Set(float64(status.BlocksBehind))
+}
+
+// setDABlockHeight records height of rollup block found in DA, if it's the highest one.
+func (m *Manager) setDABlockHeight(height uint64) {
+	for {
+		current := m.daBlockHeight.Load()
+		if height <= current || m.daBlockHeight.CompareAndSwap(current, height) {
+			return
+		}
+	}
+}
+
+func (m *Manager) setLastDASubmission(res da.ResultSubmit, gasPrice float64) {
+	m.lastDASubmissionMu.Lock()
+	defer m.lastDASubmissionMu.Unlock()
+	m.lastDASubmission = &types.DASubmission{
+		Time:           time.Now(),
+		Code:           res.Code.String(),
+		Message:        res.Message,
+		DAHeight:       res.DAHeight,
+		SubmittedCount: res.SubmittedCount,
+		GasPrice:       gasPrice,
+	}
+}
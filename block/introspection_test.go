@@ -0,0 +1,97 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	goDATest "github.com/rollkit/go-da/test"
+	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestIntrospection(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	ctx := context.Background()
+
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore)
+	saveTestChain(t, s, 5)
+
+	m := getManager(t, goDATest.NewDummyDA())
+	m.store = s
+	m.pendingHeaders, err = NewPendingHeaders(s, test.NewLogger(t))
+	require.NoError(err)
+	m.bq = NewBatchQueue()
+	m.daHeight = 7
+
+	assert.Equal(types.ResultPendingHeaders{Count: 5, FromHeight: 1, ToHeight: 5}, m.GetPendingHeaders())
+	m.pendingHeaders.setLastSubmittedHeight(ctx, 3)
+	assert.Equal(types.ResultPendingHeaders{Count: 2, FromHeight: 4, ToHeight: 5}, m.GetPendingHeaders())
+	m.pendingHeaders.setLastSubmittedHeight(ctx, 5)
+	assert.Equal(types.ResultPendingHeaders{}, m.GetPendingHeaders())
+
+	status := m.GetDAStatus()
+	assert.Equal(uint64(7), status.DAHeight)
+	assert.Equal(float64(-1), status.GasPrice)
+	assert.Nil(status.LastSubmission)
+
+	m.setLastDASubmission(da.ResultSubmit{BaseResult: da.BaseResult{Code: da.StatusNotIncludedInBlock, Message: "timeout"}}, 2.5)
+	status = m.GetDAStatus()
+	assert.Equal(2.5, status.GasPrice)
+	require.NotNil(status.LastSubmission)
+	assert.Equal("not_included_in_block", status.LastSubmission.Code)
+	assert.Equal("timeout", status.LastSubmission.Message)
+
+	assert.Equal(0, m.GetBatchQueueDepth())
+	m.bq.AddBatch(BatchWithTime{})
+	m.bq.AddBatch(BatchWithTime{})
+	assert.Equal(2, m.GetBatchQueueDepth())
+
+	syncStatus := m.GetSyncStatus()
+	assert.Equal(uint64(5), syncStatus.Height)
+	assert.Equal(uint64(7), syncStatus.DAHeight)
+	assert.Zero(syncStatus.HeaderStoreHeight)
+}
+
+func TestGetSyncStatusCatchingUp(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore)
+	saveTestChain(t, s, 5)
+
+	m := getManager(t, goDATest.NewDummyDA())
+	m.store = s
+	m.conf.CatchingUpThreshold = 5
+
+	status := m.GetSyncStatus()
+	assert.Equal(uint64(5), status.TargetHeight)
+	assert.Zero(status.BlocksBehind)
+	assert.False(status.CatchingUp)
+
+	m.setDABlockHeight(10)
+	m.setDABlockHeight(8)
+	status = m.GetSyncStatus()
+	assert.Equal(uint64(10), status.DABlockHeight)
+	assert.Equal(uint64(5), status.BlocksBehind)
+	assert.False(status.CatchingUp)
+
+	m.setDABlockHeight(11)
+	status = m.GetSyncStatus()
+	assert.Equal(uint64(11), status.TargetHeight)
+	assert.Equal(uint64(6), status.BlocksBehind)
+	assert.True(status.CatchingUp)
+
+	// proposer is never catching up
+	m.isProposer = true
+	assert.False(m.GetSyncStatus().CatchingUp)
+}
@@ -0,0 +1,105 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// leaseRetryInterval is the interval between attempts to acquire a lease held by another aggregator
+var leaseRetryInterval = time.Second
+
+var (
+	// ErrLeaseLost is returned when the aggregator is asked to produce a block without holding the lease.
+	ErrLeaseLost = errors.New("aggregator lease is not held")
+
+	// ErrStandbyWithoutLease is returned when a standby aggregator is configured without a lease.
+	// Without a lease, a standby aggregator cut off from the active one by a network partition would
+	// be promoted while the active one keeps producing blocks, so both would sign conflicting headers.
+	ErrStandbyWithoutLease = errors.New("standby aggregator requires a lease")
+)
+
+// Lease grants the exclusive right to produce and submit blocks to one of the aggregators sharing
+// the sequencer key, so that a standby aggregator can take over when the active one fails without
+// both of them producing blocks.
+//
+// Implementations must make sure that the lease can't be acquired by another aggregator until it's
+// released or the holder is known to have stopped (e.g. process died or lease expired without being
+// renewed). FileLease works only for aggregators on the same host; aggregators on different hosts
+// need a lease backed by a service they all reach, e.g. a lock in etcd or Consul with a TTL shorter
+// than the time the holder keeps producing blocks after it fails to renew it, set with SetLease.
+type Lease interface {
+	// Acquire blocks until the lease is acquired or ctx is done.
+	Acquire(ctx context.Context) error
+
+	// Held returns true if the lease is still held by the caller of Acquire.
+	Held() bool
+
+	// Release releases the lease.
+	Release() error
+}
+
+// SetLease sets the lease that has to be held by the aggregator to produce and submit blocks.
+// It must be called before starting the aggregation loop.
+func (m *Manager) SetLease(lease Lease) {
+	m.lease = lease
+}
+
+// acquireLease waits until the aggregator holds the lease, and takes over block production from
+// the state in the store.
+func (m *Manager) acquireLease(ctx context.Context) error {
+	if m.lease == nil {
+		return nil
+	}
+	m.logger.Info("Waiting for aggregator lease")
+	if err := m.lease.Acquire(ctx); err != nil {
+		return err
+	}
+	m.logger.Info("Acquired aggregator lease")
+	return m.takeOver(ctx)
+}
+
+// releaseLease releases the lease, letting a standby aggregator take over.
+func (m *Manager) releaseLease() {
+	if m.lease == nil {
+		return
+	}
+	if err := m.lease.Release(); err != nil {
+		m.logger.Error("failed to release aggregator lease", "error", err)
+	}
+}
+
+// leaseHeld returns true if the aggregator can produce and submit blocks.
+func (m *Manager) leaseHeld() bool {
+	return m.lease == nil || m.lease.Held()
+}
+
+// takeOver prepares the aggregator to continue block production from the state in the store,
+// which may have been written by another aggregator or synced from the network. Blocks already
+// included in DA are not submitted again; remaining pending headers are submitted by
+// HeaderSubmissionLoop, and a block stored but not applied yet is reused by publishBlock.
+// The last stored header is recorded by the sign guard, as if it was signed by the aggregator, so
+// that a header conflicting with the synced chain is never signed.
+func (m *Manager) takeOver(ctx context.Context) error {
+	s, err := m.store.GetState(ctx)
+	if err != nil {
+		return err
+	}
+	m.SetLastState(s)
+	m.store.SetHeight(ctx, s.LastBlockHeight)
+	if s.LastBlockHeight > m.signGuard.lastSignedHeight() {
+		header, _, err := m.store.GetBlockData(ctx, s.LastBlockHeight)
+		if err != nil {
+			return err
+		}
+		if err := m.signGuard.check(ctx, &header.Header); err != nil {
+			return err
+		}
+	}
+	m.pendingHeaders.setLastSubmittedHeight(ctx, min(m.GetDAIncludedHeight(), s.LastBlockHeight))
+	m.logger.Info("Taking over block production",
+		"height", s.LastBlockHeight,
+		"lastSignedHeight", m.signGuard.lastSignedHeight(),
+		"pendingHeaders", m.pendingHeaders.numPendingHeaders())
+	return nil
+}
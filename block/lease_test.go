@@ -0,0 +1,77 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+)
+
+// testLease is a lease that can be revoked.
+type testLease struct {
+	mtx  sync.Mutex
+	held bool
+}
+
+func (l *testLease) Acquire(context.Context) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.held = true
+	return nil
+}
+
+func (l *testLease) Held() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.held
+}
+
+func (l *testLease) Release() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.held = false
+	return nil
+}
+
+func TestTakeOver(t *testing.T) {
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+	saveTestChain(t, s, 5)
+
+	logger := test.NewLogger(t)
+	pendingHeaders, err := NewPendingHeaders(s, logger)
+	require.NoError(t, err)
+	signGuard, err := newSignGuard(ctx, s)
+	require.NoError(t, err)
+	m := &Manager{
+		store:          s,
+		lastStateMtx:   new(sync.RWMutex),
+		pendingHeaders: pendingHeaders,
+		signGuard:      signGuard,
+		logger:         logger,
+		isProposer:     true,
+	}
+	// blocks synced from DA were submitted by the previous aggregator
+	m.daIncludedHeight.Store(3)
+
+	lease := &testLease{}
+	m.SetLease(lease)
+	assert.False(t, m.leaseHeld())
+	assert.ErrorIs(t, m.publishBlock(ctx), ErrLeaseLost)
+
+	require.NoError(t, m.acquireLease(ctx))
+	assert.True(t, m.leaseHeld())
+	assert.Equal(t, uint64(5), m.lastState.LastBlockHeight)
+	assert.Equal(t, uint64(3), m.pendingHeaders.getLastSubmittedHeight())
+	assert.Equal(t, uint64(2), m.pendingHeaders.numPendingHeaders())
+
+	require.NoError(t, lease.Release())
+	assert.ErrorIs(t, m.publishBlock(ctx), ErrLeaseLost)
+}
@@ -12,17 +12,13 @@ import (
 	"sync/atomic"
 	"time"
 
-	secp256k1 "github.com/btcsuite/btcd/btcec/v2"
-	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	abci "github.com/cometbft/cometbft/abci/types"
-	cmcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/merkle"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
 	ds "github.com/ipfs/go-datastore"
-	"github.com/libp2p/go-libp2p/core/crypto"
-	"github.com/libp2p/go-libp2p/core/crypto/pb"
 	pkgErrors "github.com/pkg/errors"
 
 	goheaderstore "github.com/celestiaorg/go-header/store"
@@ -32,6 +28,7 @@ import (
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
 	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/signer"
 	"github.com/rollkit/rollkit/state"
 	"github.com/rollkit/rollkit/store"
 	"github.com/rollkit/rollkit/third_party/log"
@@ -55,6 +52,9 @@ const defaultLazyBlockTime = 60 * time.Second
 // defaultBatchRetrievalInterval is the interval at which the sequencer retrieves batches
 const defaultBatchRetrievalInterval = 1 * time.Second
 
+// defaultStandbyMissedBlocks is used only if StandbyMissedBlocks is not configured for manager
+const defaultStandbyMissedBlocks = 10
+
 // defaultMempoolTTL is the number of blocks until transaction is dropped from mempool
 const defaultMempoolTTL = 25
 
@@ -78,6 +78,24 @@ var initialBackoff = 100 * time.Millisecond
 // DAIncludedHeightKey is the key used for persisting the da included height in store.
 const DAIncludedHeightKey = "da included height"
 
+// DAHeightKeyPrefix is the prefix of keys used for persisting the DA height of the state after
+// each block in store, so that it can be restored by Rollback.
+const DAHeightKeyPrefix = "da height"
+
+func daHeightKey(height uint64) string {
+	return fmt.Sprintf("%s/%d", DAHeightKeyPrefix, height)
+}
+
+// saveState saves the state in store, along with its DA height.
+func saveState(ctx context.Context, store store.Store, s types.State) error {
+	daHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(daHeight, s.DAHeight)
+	if err := store.SetMetadata(ctx, daHeightKey(s.LastBlockHeight), daHeight); err != nil {
+		return err
+	}
+	return store.UpdateState(ctx, s)
+}
+
 var (
 	// ErrNoValidatorsInState is used when no validators/proposers are found in state
 	ErrNoValidatorsInState = errors.New("no validators found in state")
@@ -114,16 +132,27 @@ type Manager struct {
 	conf    config.BlockManagerConfig
 	genesis *cmtypes.GenesisDoc
 
-	proposerKey crypto.PrivKey
+	signer signer.Signer
+	// signGuard protects the sequencer from signing conflicting headers
+	signGuard *signGuard
+	// lease has to be held by the aggregator to produce and submit blocks, if set
+	lease Lease
 
 	executor *state.BlockExecutor
+	eventBus *cmtypes.EventBus
 
 	dalc *da.DAClient
 	// daHeight is the height of the latest processed DA block
 	daHeight uint64
+	// daBlockHeight is the height of the highest rollup block found in DA
+	daBlockHeight atomic.Uint64
 
 	HeaderCh chan *types.SignedHeader
 	DataCh   chan *types.Data
+	// EvidenceCh is used to publish newly found equivocation evidence
+	EvidenceCh chan *types.EquivocationEvidence
+	// FraudProofCh is used to publish fraud proofs generated while syncing
+	FraudProofCh chan *types.FraudProof
 
 	headerInCh  chan NewHeaderEvent
 	headerStore *goheaderstore.Store[*types.SignedHeader]
@@ -143,6 +172,24 @@ type Manager struct {
 	// retrieveCh is used to notify sync goroutine (SyncLoop) that it needs to retrieve data
 	retrieveCh chan struct{}
 
+	// equivocationCh is used to notify sync goroutine (SyncLoop) about detected sequencer equivocation
+	equivocationCh chan *types.EquivocationEvidence
+
+	// fraudCh is used to notify sync goroutine (SyncLoop) about valid fraud proofs received from the network
+	fraudCh chan *types.FraudProof
+
+	// txReplayer is used to verify fraud proofs received from the network
+	txReplayer state.TxReplayer
+
+	// daConflictCh is used to notify sync goroutine (SyncLoop) about applied blocks contradicted by DA
+	daConflictCh chan daConflict
+
+	// attester collects attestations of the committee members, see Attester
+	attester Attester
+
+	// appRollbacker is used to revert application state when resolving DA conflicts
+	appRollbacker AppRollbacker
+
 	logger log.Logger
 
 	// For usage by Lazy Aggregator mode
@@ -156,6 +203,18 @@ type Manager struct {
 
 	// true if the manager is a proposer
 	isProposer bool
+	// true if the manager is a standby aggregator that was not promoted yet
+	standby atomic.Bool
+
+	// appHaltHeight is the halt height scheduled by the application, 0 if none
+	appHaltHeight atomic.Uint64
+	// haltCh is closed when the node halts, haltReason holds the reason
+	haltCh     chan struct{}
+	haltReason error
+	haltMtx    sync.Mutex
+	// haltedCh is closed when the node stopped after halting
+	haltedCh   chan struct{}
+	haltedOnce sync.Once
 
 	// daIncludedHeight is rollup height at which all blocks have been included
 	// in the DA
@@ -164,6 +223,10 @@ type Manager struct {
 	seqClient     *grpc.Client
 	lastBatchHash []byte
 	bq            *BatchQueue
+
+	// lastDASubmission is the result of the latest attempt to submit headers to DA
+	lastDASubmission   *types.DASubmission
+	lastDASubmissionMu sync.RWMutex
 }
 
 // getInitialState tries to load lastState from Store, and if it's not available it reads GenesisDoc.
@@ -193,7 +256,7 @@ func getInitialState(store store.Store, genesis *cmtypes.GenesisDoc) (types.Stat
 
 // NewManager creates new block Manager.
 func NewManager(
-	proposerKey crypto.PrivKey,
+	signer signer.Signer,
 	conf config.BlockManagerConfig,
 	genesis *cmtypes.GenesisDoc,
 	store store.Store,
@@ -235,11 +298,33 @@ func NewManager(
 		conf.LazyBlockTime = defaultLazyBlockTime
 	}
 
+	if conf.StandbyMissedBlocks == 0 {
+		conf.StandbyMissedBlocks = defaultStandbyMissedBlocks
+	}
+
 	if conf.DAMempoolTTL == 0 {
 		logger.Info("Using default mempool ttl", "MempoolTTL", defaultMempoolTTL)
 		conf.DAMempoolTTL = defaultMempoolTTL
 	}
 
+	switch conf.EquivocationPolicy {
+	case "":
+		logger.Info("Using default equivocation policy", "EquivocationPolicy", config.EquivocationPolicyHalt)
+		conf.EquivocationPolicy = config.EquivocationPolicyHalt
+	case config.EquivocationPolicyHalt, config.EquivocationPolicyAlert:
+	default:
+		return nil, fmt.Errorf("unknown equivocation policy: %s", conf.EquivocationPolicy)
+	}
+
+	switch conf.DAConflictPolicy {
+	case "":
+		logger.Info("Using default DA conflict policy", "DAConflictPolicy", config.DAConflictPolicyHalt)
+		conf.DAConflictPolicy = config.DAConflictPolicyHalt
+	case config.DAConflictPolicyHalt, config.DAConflictPolicyRollback:
+	default:
+		return nil, fmt.Errorf("unknown DA conflict policy: %s", conf.DAConflictPolicy)
+	}
+
 	proposerAddress := s.Validators.Proposer.Address.Bytes()
 
 	maxBlobSize, err := dalc.DA.MaxBlobSize(context.Background())
@@ -249,7 +334,7 @@ func NewManager(
 	// allow buffer for the block header and protocol encoding
 	maxBlobSize -= blockProtocolOverhead
 
-	exec := state.NewBlockExecutor(proposerAddress, genesis.ChainID, mempool, mempoolReaper, proxyApp, eventBus, maxBlobSize, logger, execMetrics)
+	exec := state.NewBlockExecutor(proposerAddress, genesis.ChainID, mempool, mempoolReaper, proxyApp, eventBus, maxBlobSize, conf.IntermediateStateRoots, logger, execMetrics)
 	if s.LastBlockHeight+1 == uint64(genesis.InitialHeight) { //nolint:gosec
 		res, err := exec.InitChain(genesis)
 		if err != nil {
@@ -259,12 +344,12 @@ func NewManager(
 			return nil, err
 		}
 
-		if err := store.UpdateState(context.Background(), s); err != nil {
+		if err := saveState(context.Background(), store, s); err != nil {
 			return nil, err
 		}
 	}
 
-	isProposer, err := isProposer(proposerKey, s)
+	isProposer, err := isProposer(signer, s)
 	if err != nil {
 		return nil, err
 	}
@@ -281,18 +366,35 @@ func NewManager(
 		return nil, err
 	}
 
+	signGuard, err := newSignGuard(context.Background(), store)
+	if err != nil {
+		return nil, err
+	}
+
+	var lease Lease
+	if conf.LeaseFile != "" {
+		if lease, err = NewFileLease(conf.LeaseFile); err != nil {
+			return nil, err
+		}
+	}
+
 	agg := &Manager{
-		proposerKey: proposerKey,
-		conf:        conf,
-		genesis:     genesis,
-		lastState:   s,
-		store:       store,
-		executor:    exec,
-		dalc:        dalc,
-		daHeight:    s.DAHeight,
+		signer:    signer,
+		signGuard: signGuard,
+		lease:     lease,
+		conf:      conf,
+		genesis:   genesis,
+		lastState: s,
+		store:     store,
+		executor:  exec,
+		eventBus:  eventBus,
+		dalc:      dalc,
+		daHeight:  s.DAHeight,
 		// channels are buffered to avoid blocking on input/output operations, buffer sizes are arbitrary
 		HeaderCh:       make(chan *types.SignedHeader, channelLength),
 		DataCh:         make(chan *types.Data, channelLength),
+		EvidenceCh:     make(chan *types.EquivocationEvidence, channelLength),
+		FraudProofCh:   make(chan *types.FraudProof, channelLength),
 		headerInCh:     make(chan NewHeaderEvent, headerInChLength),
 		dataInCh:       make(chan NewDataEvent, headerInChLength),
 		headerStoreCh:  make(chan struct{}, 1),
@@ -303,6 +405,9 @@ func NewManager(
 		headerCache:    NewHeaderCache(),
 		dataCache:      NewDataCache(),
 		retrieveCh:     make(chan struct{}, 1),
+		equivocationCh: make(chan *types.EquivocationEvidence, 1),
+		fraudCh:        make(chan *types.FraudProof, 1),
+		daConflictCh:   make(chan daConflict, headerInChLength),
 		logger:         logger,
 		txsAvailable:   txsAvailableCh,
 		buildingBlock:  false,
@@ -311,8 +416,13 @@ func NewManager(
 		isProposer:     isProposer,
 		seqClient:      seqClient,
 		bq:             NewBatchQueue(),
+		haltCh:         make(chan struct{}),
+		haltedCh:       make(chan struct{}),
 	}
 	agg.init(context.Background())
+	if err := agg.loadAppHaltHeight(context.Background()); err != nil {
+		return nil, err
+	}
 	return agg, nil
 }
 
@@ -338,29 +448,72 @@ func (m *Manager) setDAIncludedHeight(ctx context.Context, newHeight uint64) err
 	return nil
 }
 
+// setDAIncluded marks the block as included in the DA layer at the given DA height.
+// NewDAInclusion event is published the first time the block is marked.
+func (m *Manager) setDAIncluded(ctx context.Context, header *types.SignedHeader, daHeight uint64) error {
+	blockHash := header.Hash()
+	alreadyIncluded := m.headerCache.isDAIncluded(blockHash.String())
+	m.headerCache.setDAIncluded(blockHash.String())
+	if err := m.setDAIncludedHeight(ctx, header.Height()); err != nil {
+		return err
+	}
+	if alreadyIncluded || m.eventBus == nil {
+		return nil
+	}
+	err := m.eventBus.Publish(types.EventNewDAInclusion, types.EventDataNewDAInclusion{
+		Height:   header.Height(),
+		Hash:     cmbytes.HexBytes(blockHash),
+		DAHeight: daHeight,
+	})
+	if err != nil {
+		m.logger.Error("failed publishing DA inclusion event", "height", header.Height(), "err", err)
+	}
+	return nil
+}
+
 // GetDAIncludedHeight returns the rollup height at which all blocks have been
 // included in the DA
 func (m *Manager) GetDAIncludedHeight() uint64 {
 	return m.daIncludedHeight.Load()
 }
 
+// GetDAHeight returns the height of the latest DA block processed by the manager.
+func (m *Manager) GetDAHeight() uint64 {
+	return atomic.LoadUint64(&m.daHeight)
+}
+
 // SetDALC is used to set DataAvailabilityLayerClient used by Manager.
 func (m *Manager) SetDALC(dalc *da.DAClient) {
 	m.dalc = dalc
 }
 
+// CheckSetup returns an error if the configuration of the manager requires an extension that isn't set.
+// It should be called before the node is started.
+func (m *Manager) CheckSetup() error {
+	if m.conf.DAConflictPolicy == config.DAConflictPolicyRollback && m.appRollbacker == nil {
+		return ErrNoAppRollbacker
+	}
+	if m.conf.Standby && m.isProposer && m.lease == nil {
+		return ErrStandbyWithoutLease
+	}
+	return nil
+}
+
 // isProposer returns whether or not the manager is a proposer
-func isProposer(signerPrivKey crypto.PrivKey, s types.State) (bool, error) {
+func isProposer(signer signer.Signer, s types.State) (bool, error) {
 	if len(s.Validators.Validators) == 0 {
 		return false, ErrNoValidatorsInState
 	}
+	if signer == nil {
+		return false, nil
+	}
 
-	signerPubBytes, err := signerPrivKey.GetPublic().Raw()
+	signerPubKey, err := signer.GetPubKey()
 	if err != nil {
 		return false, err
 	}
 
-	return bytes.Equal(s.Validators.Validators[0].PubKey.Bytes(), signerPubBytes), nil
+	return bytes.Equal(s.Validators.Validators[0].PubKey.Bytes(), signerPubKey.Bytes()), nil
 }
 
 // SetLastState is used to set lastState used by Manager.
@@ -458,6 +611,18 @@ func (m *Manager) BatchRetrieveLoop(ctx context.Context) {
 
 // AggregationLoop is responsible for aggregating transactions into rollup-blocks.
 func (m *Manager) AggregationLoop(ctx context.Context) {
+	if err := m.acquireLease(ctx); err != nil {
+		if ctx.Err() == nil {
+			m.logger.Error("failed to acquire aggregator lease", "error", err)
+		}
+		return
+	}
+	defer m.releaseLease()
+
+	if m.checkHaltOnStart(ctx) {
+		return
+	}
+
 	initialHeight := uint64(m.genesis.InitialHeight) //nolint:gosec
 	height := m.store.Height()
 	var delay time.Duration
@@ -524,6 +689,9 @@ func (m *Manager) lazyAggregationLoop(ctx context.Context, blockTimer *time.Time
 		if err := m.publishBlock(ctx); err != nil && ctx.Err() == nil {
 			m.logger.Error("error while publishing block", "error", err)
 		}
+		if m.isHalted() {
+			return
+		}
 		// unset the buildingBlocks flag
 		m.buildingBlock = false
 		// Reset the lazyTimer to produce a block even if there
@@ -544,6 +712,9 @@ func (m *Manager) normalAggregationLoop(ctx context.Context, blockTimer *time.Ti
 			if err := m.publishBlock(ctx); err != nil && ctx.Err() == nil {
 				m.logger.Error("error while publishing block", "error", err)
 			}
+			if m.isHalted() {
+				return
+			}
 			// Reset the blockTimer to signal the next block production
 			// period based on the block time.
 			blockTimer.Reset(m.getRemainingSleep(start))
@@ -552,6 +723,7 @@ func (m *Manager) normalAggregationLoop(ctx context.Context, blockTimer *time.Ti
 }
 
 // HeaderSubmissionLoop is responsible for submitting blocks to the DA layer.
+// When the node halts, all pending headers are submitted before the loop stops.
 func (m *Manager) HeaderSubmissionLoop(ctx context.Context) {
 	timer := time.NewTicker(m.conf.DABlockTime)
 	defer timer.Stop()
@@ -559,9 +731,13 @@ func (m *Manager) HeaderSubmissionLoop(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
+		case <-m.haltCh:
+			m.flushPendingHeaders(ctx)
+			m.setHalted()
+			return
 		case <-timer.C:
 		}
-		if m.pendingHeaders.isEmpty() {
+		if m.pendingHeaders.isEmpty() || !m.leaseHeld() {
 			continue
 		}
 		err := m.submitHeadersToDA(ctx)
@@ -580,6 +756,10 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 	defer daTicker.Stop()
 	blockTicker := time.NewTicker(m.conf.BlockTime)
 	defer blockTicker.Stop()
+	if m.checkHaltOnStart(ctx) {
+		m.setHalted()
+		return
+	}
 	for {
 		select {
 		case <-daTicker.C:
@@ -587,6 +767,7 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 		case <-blockTicker.C:
 			m.sendNonBlockingSignalToHeaderStoreCh()
 			m.sendNonBlockingSignalToDataStoreCh()
+			m.updateSyncMetrics()
 		case headerEvent := <-m.headerInCh:
 			// Only validated headers are sent to headerInCh, so we can safely assume that headerEvent.header is valid
 			header := headerEvent.Header
@@ -598,16 +779,27 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 				"daHeight", daHeight,
 				"hash", headerHash,
 			)
-			if headerHeight <= m.store.Height() || m.headerCache.isSeen(headerHash) {
+			if m.headerCache.isSeen(headerHash) {
 				m.logger.Debug("header already seen", "height", headerHeight, "block hash", headerHash)
 				continue
 			}
+			if m.checkEquivocation(ctx, header) {
+				continue
+			}
+			if headerHeight <= m.store.Height() {
+				m.logger.Debug("header already synced", "height", headerHeight, "block hash", headerHash)
+				continue
+			}
 			m.headerCache.setHeader(headerHeight, header)
 
 			m.sendNonBlockingSignalToHeaderStoreCh()
 			m.sendNonBlockingSignalToRetrieveCh()
 
 			err := m.trySyncNextBlock(ctx, daHeight)
+			if m.isHalted() {
+				m.setHalted()
+				return
+			}
 			if err != nil {
 				m.logger.Info("failed to sync next block", "error", err)
 				continue
@@ -632,11 +824,33 @@ func (m *Manager) SyncLoop(ctx context.Context, cancel context.CancelFunc) {
 			m.sendNonBlockingSignalToDataStoreCh()
 
 			err := m.trySyncNextBlock(ctx, daHeight)
+			if m.isHalted() {
+				m.setHalted()
+				return
+			}
 			if err != nil {
 				m.logger.Info("failed to sync next block", "error", err)
 				continue
 			}
 			m.dataCache.setSeen(dataHash)
+		case ev := <-m.equivocationCh:
+			if m.handleEquivocation(ev) {
+				cancel()
+				return
+			}
+		case proof := <-m.fraudCh:
+			m.logger.Error("chain proven fraudulent, stopped syncing", "height", proof.SignedHeader.Height())
+			return
+		case conflict := <-m.daConflictCh:
+			if err := m.resolveDAConflict(ctx, conflict); err != nil {
+				if m.isHalted() {
+					m.setHalted()
+					return
+				}
+				m.logger.Error("failed to resolve DA conflict, halting node", "error", err)
+				cancel()
+				return
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -700,9 +914,20 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 			if ctx.Err() != nil {
 				return err
 			}
+			if errors.Is(err, state.ErrFinalizeBlock) {
+				return m.stopOnAppError(currentHeight, err)
+			}
+			var fraudErr *state.FraudError
+			if errors.As(err, &fraudErr) {
+				m.reportFraud(ctx, fraudErr.Proof)
+			}
+			if errors.Is(err, state.ErrISRMismatch) {
+				return m.stopOnFraud(currentHeight, err)
+			}
 			// if call to applyBlock fails, we halt the node, see https://github.com/cometbft/cometbft/pull/496
 			panic(fmt.Errorf("failed to ApplyBlock: %w", err))
 		}
+		m.scheduleAppHalt(ctx, hHeight, responses)
 		err = m.store.SaveBlockData(ctx, h, d, &h.Signature)
 		if err != nil {
 			return fmt.Errorf("failed to save block: %w", err)
@@ -729,6 +954,14 @@ func (m *Manager) trySyncNextBlock(ctx context.Context, daHeight uint64) error {
 		}
 		m.headerCache.deleteHeader(currentHeight + 1)
 		m.dataCache.deleteData(currentHeight + 1)
+		// batches retrieved by a standby aggregator were already included by the active one
+		if m.bq != nil {
+			m.bq.removeIncluded(d.Txs)
+		}
+		if err := m.checkHalt(h); err != nil {
+			m.halt(err)
+			return err
+		}
 	}
 }
 
@@ -904,9 +1137,12 @@ func (m *Manager) processNextDAHeader(ctx context.Context) error {
 						"headerHash", header.Hash().String())
 					continue
 				}
+				if m.checkDAConflict(ctx, header, daHeight) {
+					continue
+				}
+				m.setDABlockHeight(header.Height())
 				blockHash := header.Hash().String()
-				m.headerCache.setDAIncluded(blockHash)
-				err = m.setDAIncludedHeight(ctx, header.Height())
+				err = m.setDAIncluded(ctx, header, daHeight)
 				if err != nil {
 					return err
 				}
@@ -942,7 +1178,7 @@ func (m *Manager) processNextDAHeader(ctx context.Context) error {
 }
 
 func (m *Manager) isUsingExpectedCentralizedSequencer(header *types.SignedHeader) bool {
-	return bytes.Equal(header.ProposerAddress, m.genesis.Validators[0].Address.Bytes()) && header.ValidateBasic() == nil
+	return bytes.Equal(header.ProposerAddress, m.genesis.Validators[0].Address.Bytes()) && header.ValidateBasic() == nil && m.hasRequiredCommit(header)
 }
 
 func (m *Manager) fetchHeaders(ctx context.Context, daHeight uint64) (da.ResultRetrieveHeaders, error) {
@@ -954,17 +1190,26 @@ func (m *Manager) fetchHeaders(ctx context.Context, daHeight uint64) (da.ResultR
 	return headerRes, err
 }
 
-func (m *Manager) getSignature(header types.Header) (*types.Signature, error) {
+func (m *Manager) getSignature(ctx context.Context, header types.Header) (*types.Signature, error) {
 	// note: for compatibility with tendermint light client
-	consensusVote := header.MakeCometBFTVote()
-	sign, err := m.sign(consensusVote)
-	if err != nil {
-		return nil, err
+	// proposerAddress = sequencer = validator
+	vote := header.AttestationVote(header.ProposerAddress, 0)
+	if err := m.signVote(ctx, &header, vote); err != nil {
+		return nil, fmt.Errorf("failed to sign header at height %d: %w", header.Height(), err)
 	}
-	signature := types.Signature(sign)
+	signature := types.Signature(vote.Signature)
 	return &signature, nil
 }
 
+// signVote signs the attestation vote for the header, unless the header conflicts with the last
+// header signed by the sequencer.
+func (m *Manager) signVote(ctx context.Context, header *types.Header, vote *cmproto.Vote) error {
+	if err := m.signGuard.check(ctx, header); err != nil {
+		return err
+	}
+	return m.signer.SignVote(header.ChainID(), vote)
+}
+
 func (m *Manager) getTxsFromBatch() cmtypes.Txs {
 	batch := m.bq.Next()
 	if batch == nil {
@@ -988,6 +1233,14 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 		return ErrNotProposer
 	}
 
+	if !m.leaseHeld() {
+		return ErrLeaseLost
+	}
+
+	if m.isHalted() {
+		return m.HaltReason()
+	}
+
 	if m.conf.MaxPendingBlocks != 0 && m.pendingHeaders.numPendingHeaders() >= m.conf.MaxPendingBlocks {
 		return fmt.Errorf("refusing to create block: pending blocks [%d] reached limit [%d]",
 			m.pendingHeaders.numPendingHeaders(), m.conf.MaxPendingBlocks)
@@ -995,6 +1248,7 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 
 	var (
 		lastSignature  *types.Signature
+		lastCommit     *cmtypes.Commit
 		lastHeaderHash types.Hash
 		lastDataHash   types.Hash
 		err            error
@@ -1015,12 +1269,12 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 		}
 		lastHeaderHash = lastHeader.Hash()
 		lastDataHash = lastData.Hash()
+		lastCommit = lastHeader.Commit
 	}
 
 	var (
-		header    *types.SignedHeader
-		data      *types.Data
-		signature *types.Signature
+		header *types.SignedHeader
+		data   *types.Data
 	)
 
 	// Check if there's an already stored block at a newer height
@@ -1042,41 +1296,49 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 			return err
 		}
 		m.logger.Debug("block info", "num_tx", len(data.Txs))
+		// headers attested by the committee commit to the whole commit of the previous header
+		if lastCommit != nil {
+			header.LastCommitHash = types.Hash(lastCommit.Hash())
+		}
 
 		/*
-		   here we set the SignedHeader.DataHash, and SignedHeader.Signature as a hack
-		   to make the block pass ValidateBasic() when it gets called by applyBlock on line 681
-		   these values get overridden on lines 687-698 after we obtain the IntermediateStateRoots.
+		   here we set the SignedHeader.DataHash to make the block pass validation when it gets applied.
+		   The header is signed only after we obtain the IntermediateStateRoots, so the sequencer
+		   signs a single header at each height.
 		*/
 		header.DataHash = data.Hash()
 		header.Validators = m.getLastStateValidators()
 		header.ValidatorHash = header.Validators.Hash()
 
-		signature, err = m.getSignature(header.Header)
-		if err != nil {
-			return err
-		}
-
-		// set the signature to current block's signed header
-		header.Signature = *signature
-		err = m.store.SaveBlockData(ctx, header, data, signature)
+		err = m.store.SaveBlockData(ctx, header, data, &header.Signature)
 		if err != nil {
 			return err
 		}
 	}
 
-	newState, responses, err := m.applyBlock(ctx, header, data)
+	newState, responses, err := m.applyProposal(ctx, header, data)
 	if err != nil {
 		if ctx.Err() != nil {
 			return err
 		}
+		if errors.Is(err, state.ErrFinalizeBlock) {
+			return m.stopOnAppError(height, err)
+		}
 		// if call to applyBlock fails, we halt the node, see https://github.com/cometbft/cometbft/pull/496
 		panic(err)
 	}
+	m.scheduleAppHalt(ctx, newHeight, responses)
 	// Before taking the hash, we need updated ISRs, hence after ApplyBlock
+	if err := m.executor.SetIntermediateStateRoots(m.lastState, data, responses); err != nil {
+		return err
+	}
 	header.Header.DataHash = data.Hash()
+	// The data hash changes the header hash, so the state has to refer to the final header, as
+	// the state of full nodes does. Note that FinalizeBlock was called with the hash of the header
+	// without intermediate state roots.
+	newState.LastBlockID = cmtypes.BlockID{Hash: cmbytes.HexBytes(header.Hash())}
 
-	signature, err = m.getSignature(header.Header)
+	signature, err := m.getSignature(ctx, header.Header)
 	if err != nil {
 		return err
 	}
@@ -1088,6 +1350,12 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 	// set the signature to current block's signed header
 	header.Signature = *signature
 
+	if m.committeeRequired() {
+		if err := m.attest(ctx, header); err != nil {
+			return err
+		}
+	}
+
 	// append metadata to Data before validating and saving
 	data.Metadata = &types.Metadata{
 		ChainID:      header.ChainID(),
@@ -1136,6 +1404,10 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 		return err
 	}
 	m.recordMetrics(data)
+	if err := m.checkHalt(header); err != nil {
+		// the block is still published, production stops in the aggregation loop
+		m.halt(err)
+	}
 	// Check for shut down event prior to sending the header and block to
 	// their respective channels. The reason for checking for the shutdown
 	// event separately is due to the inconsistent nature of the select
@@ -1157,28 +1429,6 @@ func (m *Manager) publishBlock(ctx context.Context) error {
 	return nil
 }
 
-func (m *Manager) sign(payload []byte) ([]byte, error) {
-	var sig []byte
-	switch m.proposerKey.Type() {
-	case pb.KeyType_Ed25519:
-		return m.proposerKey.Sign(payload)
-	case pb.KeyType_Secp256k1:
-		k := m.proposerKey.(*crypto.Secp256k1PrivateKey)
-		rawBytes, err := k.Raw()
-		if err != nil {
-			return nil, err
-		}
-		priv, _ := secp256k1.PrivKeyFromBytes(rawBytes)
-		sig, err = ecdsa.SignCompact(priv, cmcrypto.Sha256(payload), false)
-		if err != nil {
-			return nil, err
-		}
-		return sig[1:], nil
-	default:
-		return nil, fmt.Errorf("unsupported private key type: %T", m.proposerKey)
-	}
-}
-
 func (m *Manager) processVoteExtension(ctx context.Context, header *types.SignedHeader, data *types.Data, newHeight uint64) error {
 	if !m.voteExtensionEnabled(newHeight) {
 		return nil
@@ -1189,18 +1439,13 @@ func (m *Manager) processVoteExtension(ctx context.Context, header *types.Signed
 		return fmt.Errorf("error returned by ExtendVote: %w", err)
 	}
 
-	vote := &cmproto.Vote{
-		Height:    int64(newHeight), //nolint:gosec
-		Round:     0,
-		Extension: extension,
-	}
-	extSignBytes := cmtypes.VoteExtensionSignBytes(m.genesis.ChainID, vote)
-
-	sign, err := m.sign(extSignBytes)
-	if err != nil {
+	// the extension is signed along with the precommit for the header
+	vote := header.AttestationVote(header.ProposerAddress, 0)
+	vote.Extension = extension
+	if err := m.signVote(ctx, &header.Header, vote); err != nil {
 		return fmt.Errorf("failed to sign vote extension: %w", err)
 	}
-	extendedCommit := buildExtendedCommit(header, extension, sign)
+	extendedCommit := buildExtendedCommit(header, extension, vote.ExtensionSignature)
 	err = m.store.SaveExtendedCommit(ctx, newHeight, extendedCommit)
 	if err != nil {
 		return fmt.Errorf("failed to save extended commit: %w", err)
@@ -1283,6 +1528,7 @@ daSubmitRetryLoop:
 		}
 
 		res := m.dalc.SubmitHeaders(ctx, headersToSubmit, maxBlobSize, gasPrice)
+		m.setLastDASubmission(res, gasPrice)
 		switch res.Code {
 		case da.StatusSuccess:
 			m.logger.Info("successfully submitted Rollkit headers to DA layer", "gasPrice", gasPrice, "daHeight", res.DAHeight, "headerCount", res.SubmittedCount)
@@ -1292,8 +1538,7 @@ daSubmitRetryLoop:
 			submittedBlocks, notSubmittedBlocks := headersToSubmit[:res.SubmittedCount], headersToSubmit[res.SubmittedCount:]
 			numSubmittedHeaders += len(submittedBlocks)
 			for _, block := range submittedBlocks {
-				m.headerCache.setDAIncluded(block.Hash().String())
-				err = m.setDAIncludedHeight(ctx, block.Height())
+				err = m.setDAIncluded(ctx, block, res.DAHeight)
 				if err != nil {
 					return err
 				}
@@ -1366,7 +1611,7 @@ func (m *Manager) getLastStateValidators() *cmtypes.ValidatorSet {
 func (m *Manager) updateState(ctx context.Context, s types.State) error {
 	m.lastStateMtx.Lock()
 	defer m.lastStateMtx.Unlock()
-	err := m.store.UpdateState(ctx, s)
+	err := saveState(ctx, m.store, s)
 	if err != nil {
 		return err
 	}
@@ -1393,6 +1638,12 @@ func (m *Manager) applyBlock(ctx context.Context, header *types.SignedHeader, da
 	return m.executor.ApplyBlock(ctx, m.lastState, header, data)
 }
 
+func (m *Manager) applyProposal(ctx context.Context, header *types.SignedHeader, data *types.Data) (types.State, *abci.ResponseFinalizeBlock, error) {
+	m.lastStateMtx.RLock()
+	defer m.lastStateMtx.RUnlock()
+	return m.executor.ApplyProposal(ctx, m.lastState, header, data)
+}
+
 func updateState(s *types.State, res *abci.ResponseInitChain) error {
 	// If the app did not return an app hash, we keep the one set from the genesis doc in
 	// the state. We don't set appHash since we don't want the genesis doc app hash
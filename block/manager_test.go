@@ -21,7 +21,6 @@ import (
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
 	ds "github.com/ipfs/go-datastore"
-	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -36,6 +35,7 @@ import (
 	"github.com/rollkit/rollkit/da"
 	mockda "github.com/rollkit/rollkit/da/mock"
 	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/signer"
 	"github.com/rollkit/rollkit/state"
 	"github.com/rollkit/rollkit/store"
 	test "github.com/rollkit/rollkit/test/log"
@@ -162,7 +162,7 @@ func TestInitialStateUnexpectedHigherGenesis(t *testing.T) {
 func TestSignVerifySignature(t *testing.T) {
 	require := require.New(t)
 	m := getManager(t, goDATest.NewDummyDA())
-	payload := []byte("test")
+	m.genesis = &cmtypes.GenesisDoc{ChainID: "myChain"}
 	cases := []struct {
 		name  string
 		input cmcrypto.PrivKey
@@ -173,12 +173,20 @@ func TestSignVerifySignature(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			pubKey := c.input.PubKey()
-			signingKey, err := types.PrivKeyToSigningKey(c.input)
+			localSigner, err := signer.NewLocalSigner(c.input, "")
 			require.NoError(err)
-			m.proposerKey = signingKey
-			signature, err := m.sign(payload)
+			m.signer = localSigner
+			kvStore, err := store.NewDefaultInMemoryKVStore()
+			require.NoError(err)
+			m.signGuard, err = newSignGuard(context.Background(), store.New(kvStore))
 			require.NoError(err)
-			ok := pubKey.VerifySignature(payload, signature)
+			header := types.Header{
+				BaseHeader:      types.BaseHeader{ChainID: "myChain", Height: 1, Time: uint64(time.Now().UnixNano())},
+				ProposerAddress: pubKey.Address(),
+			}
+			signature, err := m.getSignature(context.Background(), header)
+			require.NoError(err)
+			ok := pubKey.VerifySignature(header.MakeCometBFTVote(), *signature)
 			require.True(ok)
 		})
 	}
@@ -201,6 +209,53 @@ func TestIsDAIncluded(t *testing.T) {
 	require.True(m.IsDAIncluded(hash))
 }
 
+func TestSetDAIncludedPublishesEvent(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	eventBus := cmtypes.NewEventBus()
+	require.NoError(eventBus.Start())
+	defer func() {
+		require.NoError(eventBus.Stop())
+	}()
+	sub, err := eventBus.Subscribe(ctx, "test", types.EventQueryNewDAInclusion)
+	require.NoError(err)
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+
+	m := &Manager{
+		store:       store.New(kvStore),
+		headerCache: NewHeaderCache(),
+		eventBus:    eventBus,
+		logger:      test.NewLogger(t),
+	}
+	header, _ := types.GetRandomBlock(5, 1)
+
+	require.NoError(m.setDAIncluded(ctx, header, 42))
+	assert.True(m.IsDAIncluded(header.Hash()))
+	assert.Equal(uint64(5), m.GetDAIncludedHeight())
+
+	select {
+	case msg := <-sub.Out():
+		event, ok := msg.Data().(types.EventDataNewDAInclusion)
+		require.True(ok)
+		assert.Equal(uint64(5), event.Height)
+		assert.Equal(uint64(42), event.DAHeight)
+		assert.EqualValues(header.Hash(), event.Hash)
+	case <-time.After(time.Second):
+		t.Fatal("NewDAInclusion event not published")
+	}
+
+	// event is published only once per block
+	require.NoError(m.setDAIncluded(ctx, header, 43))
+	select {
+	case <-sub.Out():
+		t.Fatal("unexpected NewDAInclusion event")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestSubmitBlocksToMockDA(t *testing.T) {
 	ctx := context.Background()
 
@@ -494,8 +549,8 @@ func Test_isProposer(t *testing.T) {
 	require := require.New(t)
 
 	type args struct {
-		state         types.State
-		signerPrivKey crypto.PrivKey
+		state  types.State
+		signer signer.Signer
 	}
 	tests := []struct {
 		name       string
@@ -509,11 +564,11 @@ func Test_isProposer(t *testing.T) {
 				genesisData, privKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
 				s, err := types.NewFromGenesisDoc(genesisData)
 				require.NoError(err)
-				signingKey, err := types.PrivKeyToSigningKey(privKey)
+				localSigner, err := signer.NewLocalSigner(privKey, "")
 				require.NoError(err)
 				return args{
 					s,
-					signingKey,
+					localSigner,
 				}
 			}(),
 			isProposer: true,
@@ -527,11 +582,11 @@ func Test_isProposer(t *testing.T) {
 				require.NoError(err)
 
 				randomPrivKey := ed25519.GenPrivKey()
-				signingKey, err := types.PrivKeyToSigningKey(randomPrivKey)
+				localSigner, err := signer.NewLocalSigner(randomPrivKey, "")
 				require.NoError(err)
 				return args{
 					s,
-					signingKey,
+					localSigner,
 				}
 			}(),
 			isProposer: false,
@@ -545,11 +600,11 @@ func Test_isProposer(t *testing.T) {
 				s, err := types.NewFromGenesisDoc(genesisData)
 				require.NoError(err)
 
-				signingKey, err := types.PrivKeyToSigningKey(privKey)
+				localSigner, err := signer.NewLocalSigner(privKey, "")
 				require.NoError(err)
 				return args{
 					s,
-					signingKey,
+					localSigner,
 				}
 			}(),
 			isProposer: false,
@@ -558,7 +613,7 @@ func Test_isProposer(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			isProposer, err := isProposer(tt.args.signerPrivKey, tt.args.state)
+			isProposer, err := isProposer(tt.args.signer, tt.args.state)
 			if !errors.Is(err, tt.err) {
 				t.Errorf("isProposer() error = %v, expected err %v", err, tt.err)
 				return
@@ -645,9 +700,9 @@ func TestManager_publishBlock(t *testing.T) {
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	))
 	mpoolReaper := mempool.NewCListMempoolReaper(mpool, []byte("test"), seqClient, logger)
-	executor := state.NewBlockExecutor(vKey.PubKey().Address(), "test", mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, logger, state.NopMetrics())
+	executor := state.NewBlockExecutor(vKey.PubKey().Address(), "test", mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, false, logger, state.NopMetrics())
 
-	signingKey, err := types.PrivKeyToSigningKey(vKey)
+	localSigner, err := signer.NewLocalSigner(vKey, "")
 	require.NoError(err)
 	m := &Manager{
 		lastState:    lastState,
@@ -666,9 +721,10 @@ func TestManager_publishBlock(t *testing.T) {
 			BlockTime:      time.Second,
 			LazyAggregator: false,
 		},
-		isProposer:  true,
-		proposerKey: signingKey,
-		metrics:     NopMetrics(),
+		isProposer: true,
+		signer:     localSigner,
+		signGuard:  &signGuard{store: mockStore},
+		metrics:    NopMetrics(),
 	}
 
 	t.Run("height should not be updated if saving block responses fails", func(t *testing.T) {
@@ -691,6 +747,7 @@ func TestManager_publishBlock(t *testing.T) {
 		mockStore.On("GetBlockData", mock.Anything, uint64(1)).Return(header, data, nil).Once()
 		mockStore.On("SaveBlockData", mock.Anything, header, data, mock.Anything).Return(nil).Once()
 		mockStore.On("SaveBlockResponses", mock.Anything, uint64(0), mock.Anything).Return(errors.New("failed to save block responses")).Once()
+		mockStore.On("SetMetadata", mock.Anything, LastSignedHeaderKey, mock.Anything).Return(nil).Maybe()
 
 		ctx := context.Background()
 		err = m.publishBlock(ctx)
@@ -26,6 +26,10 @@ type Metrics struct {
 	TotalTxs metrics.Gauge
 	// The latest block height.
 	CommittedHeight metrics.Gauge `metrics_name:"latest_block_height"`
+	// Whether the node is catching up (1) or synced (0).
+	CatchingUp metrics.Gauge
+	// Number of blocks the node is behind the highest known height.
+	BlocksBehind metrics.Gauge
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -67,6 +71,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "latest_block_height",
 			Help:      "The latest block height.",
 		}, labels).With(labelsAndValues...),
+		CatchingUp: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "catching_up",
+			Help:      "Whether the node is catching up (1) or synced (0).",
+		}, labels).With(labelsAndValues...),
+		BlocksBehind: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "blocks_behind",
+			Help:      "Number of blocks the node is behind the highest known height.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -78,5 +94,7 @@ func NopMetrics() *Metrics {
 		BlockSizeBytes:  discard.NewGauge(),
 		TotalTxs:        discard.NewGauge(),
 		CommittedHeight: discard.NewGauge(),
+		CatchingUp:      discard.NewGauge(),
+		BlocksBehind:    discard.NewGauge(),
 	}
 }
@@ -83,6 +83,10 @@ func (pb *PendingHeaders) numPendingHeaders() uint64 {
 	return pb.store.Height() - pb.lastSubmittedHeight.Load()
 }
 
+func (pb *PendingHeaders) getLastSubmittedHeight() uint64 {
+	return pb.lastSubmittedHeight.Load()
+}
+
 func (pb *PendingHeaders) setLastSubmittedHeight(ctx context.Context, newLastSubmittedHeight uint64) {
 	lsh := pb.lastSubmittedHeight.Load()
 
@@ -0,0 +1,246 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// ReplayOptions configures ReplayBlocks.
+type ReplayOptions struct {
+	// From is the height of the first block to replay. The application has to be at height
+	// From-1. If From is the initial height, the application has to be fresh, and it is
+	// initialized with InitChain. If From is 0, the initial height is used.
+	From uint64
+
+	// To is the height of the last block to replay. If To is 0, blocks are replayed up to the
+	// last block of the state.
+	To uint64
+
+	// DiffResponses enables comparison of the responses of the application with the responses
+	// saved in the store.
+	DiffResponses bool
+}
+
+// ReplayResult is the outcome of ReplayBlocks.
+type ReplayResult struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	// LastHeight is the height of the last block replayed without divergence.
+	LastHeight uint64 `json:"last_height"`
+	// Divergence is the first divergence found, or nil if all blocks were replayed successfully.
+	Divergence *Divergence `json:"divergence,omitempty"`
+}
+
+// Divergence describes how the results of replaying the block at Height differ from the results
+// saved in the store. Height is the initial height minus one if the results of InitChain differ.
+type Divergence struct {
+	Height   uint64   `json:"height"`
+	Problems []string `json:"problems"`
+}
+
+// ReplayBlocks executes blocks saved in the store against the application of exec, and compares
+// the resulting app hash and last results hash of every block with the ones of the next header,
+// or of the state for the last block of the state. The store is not modified.
+//
+// Replay stops at the first block whose results diverge, as following blocks can't be applied on
+// top of it. The divergence is reported in the result and doesn't cause an error.
+//
+// If the replay doesn't start at the initial height, the state before the first block is rebuilt
+// from the stored headers and the state of the store, so validator and consensus params updates
+// made before the first block are assumed to be still in effect.
+func ReplayBlocks(ctx context.Context, s store.Store, genesis *cmtypes.GenesisDoc, exec *state.BlockExecutor, opts ReplayOptions) (*ReplayResult, error) {
+	last, err := s.GetState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+	result := &ReplayResult{From: opts.From, To: opts.To}
+	if result.From == 0 {
+		result.From = last.InitialHeight
+	}
+	if result.To == 0 {
+		result.To = last.LastBlockHeight
+	}
+	if result.From < last.InitialHeight || result.From > result.To || result.To > last.LastBlockHeight {
+		return nil, fmt.Errorf("cannot replay blocks %d to %d, initial height is %d and last block height is %d",
+			result.From, result.To, last.InitialHeight, last.LastBlockHeight)
+	}
+	result.LastHeight = result.From - 1
+
+	header, data, err := s.GetBlockData(ctx, result.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block at height %d: %w", result.From, err)
+	}
+	st, err := replayInitialState(ctx, s, genesis, exec, last, header)
+	if err != nil {
+		return nil, err
+	}
+	if problems := compareReplayedState(st, header.AppHash, header.LastResultsHash); len(problems) > 0 {
+		result.Divergence = &Divergence{Height: result.From - 1, Problems: problems}
+		return result, nil
+	}
+
+	for height := result.From; height <= result.To; height++ {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		newState, responses, err := exec.ApplyBlock(ctx, st, header, data)
+		if err != nil {
+			return result, fmt.Errorf("failed to apply block at height %d: %w", height, err)
+		}
+
+		var problems []string
+		var next *types.SignedHeader
+		if height < last.LastBlockHeight {
+			if next, data, err = s.GetBlockData(ctx, height+1); err != nil {
+				return result, fmt.Errorf("failed to load block at height %d: %w", height+1, err)
+			}
+			problems = compareReplayedState(newState, next.AppHash, next.LastResultsHash)
+		} else {
+			problems = compareReplayedState(newState, last.AppHash, last.LastResultsHash)
+		}
+		if opts.DiffResponses {
+			stored, err := s.GetBlockResponses(ctx, height)
+			if err != nil {
+				return result, fmt.Errorf("failed to load block responses at height %d: %w", height, err)
+			}
+			for _, diff := range DiffResponses(stored, responses) {
+				problems = append(problems, "responses: "+diff)
+			}
+		}
+		if len(problems) > 0 {
+			result.Divergence = &Divergence{Height: height, Problems: problems}
+			return result, nil
+		}
+
+		if _, _, err := exec.Commit(ctx, newState, header, data, responses); err != nil {
+			return result, fmt.Errorf("failed to commit block at height %d: %w", height, err)
+		}
+		result.LastHeight = height
+		st = newState
+		header = next
+	}
+	return result, nil
+}
+
+// replayInitialState returns the state before first, initializing the application with
+// InitChain if first is at the initial height.
+func replayInitialState(ctx context.Context, s store.Store, genesis *cmtypes.GenesisDoc, exec *state.BlockExecutor, last types.State, first *types.SignedHeader) (types.State, error) {
+	if first.Height() == last.InitialHeight {
+		st, err := types.NewFromGenesisDoc(genesis)
+		if err != nil {
+			return types.State{}, err
+		}
+		res, err := exec.InitChain(genesis)
+		if err != nil {
+			return types.State{}, fmt.Errorf("failed to initialize chain: %w", err)
+		}
+		if err := updateState(&st, res); err != nil {
+			return types.State{}, err
+		}
+		return st, nil
+	}
+
+	prev, _, err := s.GetBlockData(ctx, first.Height()-1)
+	if err != nil {
+		return types.State{}, fmt.Errorf("failed to load block at height %d: %w", first.Height()-1, err)
+	}
+	st := last
+	st.LastBlockHeight = prev.Height()
+	st.LastBlockTime = prev.Time()
+	st.LastBlockID = cmtypes.BlockID{Hash: cmbytes.HexBytes(prev.Hash())}
+	// the application is expected to be at the state attested by the first header
+	st.AppHash = first.AppHash
+	st.LastResultsHash = first.LastResultsHash
+	return st, nil
+}
+
+// compareReplayedState compares the app hash and last results hash of st with the expected ones.
+func compareReplayedState(st types.State, appHash, lastResultsHash types.Hash) []string {
+	var problems []string
+	if !bytes.Equal(st.AppHash, appHash) {
+		problems = append(problems, fmt.Sprintf("app hash: expected %X, got %X", []byte(appHash), []byte(st.AppHash)))
+	}
+	if !bytes.Equal(st.LastResultsHash, lastResultsHash) {
+		problems = append(problems, fmt.Sprintf("last results hash: expected %X, got %X", []byte(lastResultsHash), []byte(st.LastResultsHash)))
+	}
+	return problems
+}
+
+// DiffResponses returns the differences between the expected and actual responses of a block,
+// one per line, or nil if the responses are equal.
+func DiffResponses(expected, actual *abci.ResponseFinalizeBlock) []string {
+	var diffs []string
+	if len(expected.TxResults) != len(actual.TxResults) {
+		diffs = append(diffs, fmt.Sprintf("tx results: expected %d, got %d", len(expected.TxResults), len(actual.TxResults)))
+	}
+	for i := 0; i < len(expected.TxResults) && i < len(actual.TxResults); i++ {
+		diffs = append(diffs, diffTxResult(i, expected.TxResults[i], actual.TxResults[i])...)
+	}
+	if !bytes.Equal(expected.AppHash, actual.AppHash) {
+		diffs = append(diffs, fmt.Sprintf("app hash: expected %X, got %X", expected.AppHash, actual.AppHash))
+	}
+	if !eventsEqual(expected.Events, actual.Events) {
+		diffs = append(diffs, fmt.Sprintf("block events differ: expected %d events, got %d", len(expected.Events), len(actual.Events)))
+	}
+	if !validatorUpdatesEqual(expected.ValidatorUpdates, actual.ValidatorUpdates) {
+		diffs = append(diffs, fmt.Sprintf("validator updates: expected %v, got %v", expected.ValidatorUpdates, actual.ValidatorUpdates))
+	}
+	if !proto.Equal(expected.ConsensusParamUpdates, actual.ConsensusParamUpdates) {
+		diffs = append(diffs, fmt.Sprintf("consensus param updates: expected %v, got %v", expected.ConsensusParamUpdates, actual.ConsensusParamUpdates))
+	}
+	return diffs
+}
+
+func diffTxResult(i int, expected, actual *abci.ExecTxResult) []string {
+	var diffs []string
+	if expected.Code != actual.Code || expected.Codespace != actual.Codespace {
+		diffs = append(diffs, fmt.Sprintf("tx %d code: expected %s/%d, got %s/%d", i, expected.Codespace, expected.Code, actual.Codespace, actual.Code))
+	}
+	if !bytes.Equal(expected.Data, actual.Data) {
+		diffs = append(diffs, fmt.Sprintf("tx %d data: expected %X, got %X", i, expected.Data, actual.Data))
+	}
+	if expected.GasWanted != actual.GasWanted || expected.GasUsed != actual.GasUsed {
+		diffs = append(diffs, fmt.Sprintf("tx %d gas: expected %d/%d, got %d/%d (wanted/used)", i, expected.GasWanted, expected.GasUsed, actual.GasWanted, actual.GasUsed))
+	}
+	if expected.Log != actual.Log {
+		diffs = append(diffs, fmt.Sprintf("tx %d log: expected %q, got %q", i, expected.Log, actual.Log))
+	}
+	if !eventsEqual(expected.Events, actual.Events) {
+		diffs = append(diffs, fmt.Sprintf("tx %d events differ: expected %d events, got %d", i, len(expected.Events), len(actual.Events)))
+	}
+	return diffs
+}
+
+func eventsEqual(expected, actual []abci.Event) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if !proto.Equal(&expected[i], &actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func validatorUpdatesEqual(expected, actual []abci.ValidatorUpdate) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if !proto.Equal(&expected[i], &actual[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestReplayBlocks(t *testing.T) {
+	ctx := context.Background()
+	chain := newArchiveTestChain(t, 4)
+	s := newArchiveTestStore(t)
+	chain.save(t, s)
+
+	// stored responses, with modifications of the app at given height
+	respond := func(modify map[uint64]func(*abci.ResponseFinalizeBlock)) func(*abci.RequestFinalizeBlock) *abci.ResponseFinalizeBlock {
+		return func(req *abci.RequestFinalizeBlock) *abci.ResponseFinalizeBlock {
+			resp := *chain.responses[req.Height-1]
+			resp.TxResults = []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Data: chain.responses[req.Height-1].TxResults[0].Data}}
+			if f, ok := modify[uint64(req.Height)]; ok { //nolint:gosec
+				f(&resp)
+			}
+			return &resp
+		}
+	}
+
+	cases := []struct {
+		name       string
+		opts       ReplayOptions
+		modify     map[uint64]func(*abci.ResponseFinalizeBlock)
+		lastHeight uint64
+		divergence *Divergence
+	}{
+		{
+			name:       "all blocks",
+			lastHeight: 4,
+		},
+		{
+			name:       "range",
+			opts:       ReplayOptions{From: 2, To: 3},
+			lastHeight: 3,
+		},
+		{
+			name: "diverging app hash",
+			modify: map[uint64]func(*abci.ResponseFinalizeBlock){
+				2: func(resp *abci.ResponseFinalizeBlock) { resp.AppHash = []byte{1, 2, 3} },
+			},
+			lastHeight: 1,
+			divergence: &Divergence{Height: 2, Problems: []string{
+				"app hash: expected " + types.Hash(chain.headers[2].AppHash).String() + ", got 010203",
+			}},
+		},
+		{
+			name: "diverging app hash of last block",
+			modify: map[uint64]func(*abci.ResponseFinalizeBlock){
+				4: func(resp *abci.ResponseFinalizeBlock) { resp.AppHash = []byte{1, 2, 3} },
+			},
+			lastHeight: 3,
+			divergence: &Divergence{Height: 4, Problems: []string{
+				"app hash: expected " + types.Hash(chain.responses[3].AppHash).String() + ", got 010203",
+			}},
+		},
+		{
+			name: "different responses ignored",
+			modify: map[uint64]func(*abci.ResponseFinalizeBlock){
+				3: func(resp *abci.ResponseFinalizeBlock) { resp.TxResults[0].Log = "changed" },
+			},
+			lastHeight: 4,
+		},
+		{
+			name: "different responses",
+			opts: ReplayOptions{DiffResponses: true},
+			modify: map[uint64]func(*abci.ResponseFinalizeBlock){
+				3: func(resp *abci.ResponseFinalizeBlock) { resp.TxResults[0].Log = "changed" },
+			},
+			lastHeight: 2,
+			divergence: &Divergence{Height: 3, Problems: []string{
+				`responses: tx 0 log: expected "", got "changed"`,
+			}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			exec := newArchiveTestExecutor(t, chain, respond(c.modify))
+			result, err := ReplayBlocks(ctx, s, chain.genesis, exec, c.opts)
+			require.NoError(t, err)
+			assert.Equal(t, c.lastHeight, result.LastHeight)
+			assert.Equal(t, c.divergence, result.Divergence)
+		})
+	}
+
+	_, err := ReplayBlocks(ctx, s, chain.genesis, newArchiveTestExecutor(t, chain, respond(nil)), ReplayOptions{From: 3, To: 5})
+	assert.Error(t, err)
+}
+
+func TestDiffResponses(t *testing.T) {
+	assert := assert.New(t)
+	expected := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Data: []byte{1}, GasUsed: 10}},
+		AppHash:   []byte{1},
+	}
+	assert.Empty(DiffResponses(expected, expected))
+
+	actual := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{Code: 1, Codespace: "app", Data: []byte{1}, GasUsed: 11}, {}},
+		AppHash:   []byte{2},
+		Events:    []abci.Event{{Type: "upgrade"}},
+	}
+	assert.Equal([]string{
+		"tx results: expected 1, got 2",
+		"tx 0 code: expected /0, got app/1",
+		"tx 0 gas: expected 0/10, got 0/11 (wanted/used)",
+		"app hash: expected 01, got 02",
+		"block events differ: expected 0 events, got 1",
+	}, DiffResponses(expected, actual))
+}
@@ -0,0 +1,177 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// AppRollbacker is implemented by applications able to revert their state to a previous height.
+//
+// ABCI doesn't provide a method for rolling back application state, so this has to be supplied
+// by the application embedding the node.
+type AppRollbacker interface {
+	// Rollback reverts application state to the state after committing the block at given height.
+	Rollback(ctx context.Context, height uint64) error
+}
+
+// RollbackQueryPath is the ABCI query path used by ABCIAppRollbacker.
+//
+// The height to roll back to is passed as query height. The application has to revert its state to
+// the state after committing the block at this height, so that the last block height reported by
+// Info is the given height, and return OK. Applications not supporting rollback return an error code.
+const RollbackQueryPath = "/rollkit/rollback"
+
+// ABCIAppRollbacker is an AppRollbacker reverting application state using ABCI queries.
+type ABCIAppRollbacker struct {
+	proxyApp proxy.AppConnQuery
+}
+
+var _ AppRollbacker = &ABCIAppRollbacker{}
+
+// NewABCIAppRollbacker returns an AppRollbacker using the query connection to the application.
+func NewABCIAppRollbacker(proxyApp proxy.AppConnQuery) *ABCIAppRollbacker {
+	return &ABCIAppRollbacker{proxyApp: proxyApp}
+}
+
+// Rollback reverts application state to given height, see RollbackQueryPath.
+func (r *ABCIAppRollbacker) Rollback(ctx context.Context, height uint64) error {
+	resp, err := r.proxyApp.Query(ctx, &abci.RequestQuery{
+		Path:   RollbackQueryPath,
+		Height: int64(height), //nolint:gosec
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.IsOK() {
+		return fmt.Errorf("rollback query failed with code %d: %s", resp.Code, resp.Log)
+	}
+	return nil
+}
+
+// Rollback removes all blocks above given height from the store and restores the state
+// after the block at given height. The restored state is saved in the store and returned.
+// DA included height and the height of the last header submitted to DA are lowered to given height.
+//
+// AppHash and LastResultsHash are taken from the first removed header, as each header
+// commits to the results of executing its parent, and validator sets are taken from the headers
+// around given height. DA height is restored from the store, if it was saved with the state.
+// Consensus parameters are not reverted. Application state has to be rolled back separately.
+//
+// The state is saved before the blocks are removed. If the rollback is interrupted, it's resumed by
+// rolling back to the same height again, which removes the remaining blocks.
+func Rollback(ctx context.Context, s store.Store, genesis *cmtypes.GenesisDoc, height uint64) (types.State, error) {
+	st, err := s.GetState(ctx)
+	if err != nil {
+		return types.State{}, fmt.Errorf("failed to load state: %w", err)
+	}
+	if height > st.LastBlockHeight {
+		return types.State{}, fmt.Errorf("cannot rollback to height %d, last block height is %d", height, st.LastBlockHeight)
+	}
+	if height+1 < st.InitialHeight {
+		return types.State{}, fmt.Errorf("cannot rollback to height %d, initial height is %d", height, st.InitialHeight)
+	}
+	if height < st.LastBlockHeight {
+		if st, err = restoreState(ctx, s, genesis, st, height); err != nil {
+			return types.State{}, err
+		}
+		// state is saved first, as height of the store is restored from the state on restart
+		if err := s.UpdateState(ctx, st); err != nil {
+			return types.State{}, fmt.Errorf("failed to save state: %w", err)
+		}
+	}
+	// height of the store is kept in memory and restored from the state by NewManager, so it's zero
+	// in a store opened by the rollback command
+	s.SetHeight(ctx, height)
+	if err := s.Rollback(ctx, height); err != nil {
+		return types.State{}, err
+	}
+	if err := rollbackDAHeights(ctx, s, height); err != nil {
+		return types.State{}, err
+	}
+	return st, nil
+}
+
+// restoreState returns the state after the block at given height, below the last block of st.
+func restoreState(ctx context.Context, s store.Store, genesis *cmtypes.GenesisDoc, st types.State, height uint64) (types.State, error) {
+	next, _, err := s.GetBlockData(ctx, height+1)
+	if err != nil {
+		return types.State{}, fmt.Errorf("failed to load block at height %d: %w", height+1, err)
+	}
+	// each header carries the validators of the state after its parent
+	validators := st.Validators
+	if height+1 < st.LastBlockHeight {
+		afterNext, _, err := s.GetBlockData(ctx, height+2)
+		if err != nil {
+			return types.State{}, fmt.Errorf("failed to load block at height %d: %w", height+2, err)
+		}
+		validators = afterNext.Validators
+	}
+	if validators != nil {
+		st.NextValidators = validators.Copy()
+	}
+	if next.Validators != nil {
+		st.Validators = next.Validators.Copy()
+		st.LastValidators = next.Validators.Copy()
+	}
+
+	st.LastBlockHeight = height
+	st.AppHash = next.AppHash
+	st.LastResultsHash = next.LastResultsHash
+	if height < st.InitialHeight {
+		st.LastBlockTime = genesis.GenesisTime
+		st.LastBlockID = cmtypes.BlockID{}
+	} else {
+		header, _, err := s.GetBlockData(ctx, height)
+		if err != nil {
+			return types.State{}, fmt.Errorf("failed to load block at height %d: %w", height, err)
+		}
+		st.LastBlockTime = header.Time()
+		st.LastBlockID = cmtypes.BlockID{
+			Hash: cmbytes.HexBytes(header.Hash()),
+		}
+		if header.Validators != nil {
+			st.LastValidators = header.Validators.Copy()
+		}
+	}
+
+	raw, err := s.GetMetadata(ctx, daHeightKey(height))
+	if err == nil && len(raw) == 8 {
+		st.DAHeight = binary.BigEndian.Uint64(raw)
+	} else if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return types.State{}, fmt.Errorf("failed to load DA height: %w", err)
+	}
+	return st, nil
+}
+
+// rollbackDAHeights lowers DA included height and the height of the last header submitted to DA,
+// if they are above given height, so removed blocks are not considered to be included in DA.
+func rollbackDAHeights(ctx context.Context, s store.Store, height uint64) error {
+	if raw, err := s.GetMetadata(ctx, DAIncludedHeightKey); err == nil && len(raw) == 8 && binary.BigEndian.Uint64(raw) > height {
+		heightBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(heightBytes, height)
+		if err := s.SetMetadata(ctx, DAIncludedHeightKey, heightBytes); err != nil {
+			return fmt.Errorf("failed to save DA included height: %w", err)
+		}
+	}
+	if raw, err := s.GetMetadata(ctx, LastSubmittedHeightKey); err == nil {
+		lsh, err := strconv.ParseUint(string(raw), 10, 64)
+		if err == nil && lsh > height {
+			if err := s.SetMetadata(ctx, LastSubmittedHeightKey, []byte(strconv.FormatUint(height, 10))); err != nil {
+				return fmt.Errorf("failed to save last submitted height: %w", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package block
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+// saveTestChain saves n consecutive blocks signed by the same key and the state after the last block.
+func saveTestChain(t *testing.T, s store.Store, n int) ([]*types.SignedHeader, []*types.Data, cmcrypto.PrivKey) {
+	t.Helper()
+	ctx := context.Background()
+
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	headers := []*types.SignedHeader{header}
+	blocks := []*types.Data{data}
+	for i := 1; i < n; i++ {
+		header, data = types.GetRandomNextBlock(header, data, privKey, types.GetRandomBytes(32), 1)
+		headers = append(headers, header)
+		blocks = append(blocks, data)
+	}
+	for i, header := range headers {
+		require.NoError(t, s.SaveBlockData(ctx, header, blocks[i], &header.Signature))
+		s.SetHeight(ctx, header.Height())
+	}
+
+	last := headers[len(headers)-1]
+	require.NoError(t, s.UpdateState(ctx, types.State{
+		ChainID:         last.ChainID(),
+		InitialHeight:   1,
+		LastBlockHeight: last.Height(),
+		LastBlockTime:   last.Time(),
+		LastBlockID:     cmtypes.BlockID{Hash: cmbytes.HexBytes(last.Hash())},
+		AppHash:         types.GetRandomBytes(32),
+		Validators:      last.Validators,
+		NextValidators:  last.Validators,
+		LastValidators:  last.Validators,
+	}))
+	return headers, blocks, privKey
+}
+
+func TestRollback(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore)
+	headers, _, _ := saveTestChain(t, s, 3)
+	genesis := &cmtypes.GenesisDoc{ChainID: headers[0].ChainID()}
+	daIncludedHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(daIncludedHeight, 3)
+	require.NoError(s.SetMetadata(ctx, DAIncludedHeightKey, daIncludedHeight))
+	require.NoError(s.SetMetadata(ctx, LastSubmittedHeightKey, []byte("2")))
+
+	daHeight := make([]byte, 8)
+	binary.BigEndian.PutUint64(daHeight, 7)
+	require.NoError(s.SetMetadata(ctx, daHeightKey(1), daHeight))
+
+	_, err = Rollback(ctx, s, genesis, 4)
+	require.Error(err)
+
+	st, err := Rollback(ctx, s, genesis, 1)
+	require.NoError(err)
+	assert.Equal(uint64(1), st.LastBlockHeight)
+	assert.Equal(headers[0].Time(), st.LastBlockTime)
+	assert.EqualValues(headers[0].Hash(), st.LastBlockID.Hash)
+	assert.Equal(headers[1].AppHash, st.AppHash)
+	assert.Equal(headers[0].Validators.Hash(), st.LastValidators.Hash())
+	assert.Equal(headers[1].Validators.Hash(), st.Validators.Hash())
+	assert.Equal(headers[2].Validators.Hash(), st.NextValidators.Hash())
+	assert.Equal(uint64(7), st.DAHeight)
+	assert.Equal(uint64(1), s.Height())
+
+	stored, err := s.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(st.LastBlockHeight, stored.LastBlockHeight)
+	assert.Equal(st.AppHash, stored.AppHash)
+
+	_, _, err = s.GetBlockData(ctx, 2)
+	assert.ErrorIs(err, ds.ErrNotFound)
+
+	raw, err := s.GetMetadata(ctx, DAIncludedHeightKey)
+	require.NoError(err)
+	assert.Equal(uint64(1), binary.BigEndian.Uint64(raw))
+	raw, err = s.GetMetadata(ctx, LastSubmittedHeightKey)
+	require.NoError(err)
+	assert.Equal("1", string(raw))
+
+	// rollback to the height of the state is a no-op
+	resumed, err := Rollback(ctx, s, genesis, 1)
+	require.NoError(err)
+	assert.Equal(st.LastBlockID, resumed.LastBlockID)
+	assert.Equal(st.AppHash, resumed.AppHash)
+
+	// rollback of all blocks restores the state from before the initial block
+	st, err = Rollback(ctx, s, genesis, 0)
+	require.NoError(err)
+	assert.Equal(uint64(0), st.LastBlockHeight)
+	assert.Equal(headers[0].AppHash, st.AppHash)
+	assert.Equal(genesis.GenesisTime, st.LastBlockTime)
+	assert.Equal(uint64(0), s.Height())
+}
+
+func TestRollbackResume(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := store.New(kvStore)
+	headers, _, _ := saveTestChain(t, s, 3)
+	genesis := &cmtypes.GenesisDoc{ChainID: headers[0].ChainID()}
+
+	// rollback interrupted after saving the state
+	st, err := s.GetState(ctx)
+	require.NoError(err)
+	st, err = restoreState(ctx, s, genesis, st, 1)
+	require.NoError(err)
+	require.NoError(s.UpdateState(ctx, st))
+
+	// fresh store, as opened by the rollback command
+	s = store.New(kvStore)
+	resumed, err := Rollback(ctx, s, genesis, 1)
+	require.NoError(err)
+	require.Equal(uint64(1), resumed.LastBlockHeight)
+	require.Equal(uint64(1), s.Height())
+	for _, height := range []uint64{2, 3} {
+		_, _, err = s.GetBlockData(ctx, height)
+		require.ErrorIs(err, ds.ErrNotFound)
+	}
+}
+
+func TestABCIAppRollbacker(t *testing.T) {
+	app := &mocks.Application{}
+	app.On("Query", mock.Anything, &abci.RequestQuery{Path: RollbackQueryPath, Height: 5}).Return(&abci.ResponseQuery{}, nil)
+	app.On("Query", mock.Anything, mock.Anything).Return(&abci.ResponseQuery{Code: 1, Log: "unknown path"}, nil)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+	rollbacker := NewABCIAppRollbacker(proxy.NewAppConnQuery(client, proxy.NopMetrics()))
+
+	require.NoError(t, rollbacker.Rollback(context.Background(), 5))
+	assert.ErrorContains(t, rollbacker.Rollback(context.Background(), 4), "unknown path")
+}
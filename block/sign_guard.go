@@ -0,0 +1,121 @@
+package block
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// LastSignedHeaderKey is the key used for persisting the height and hash of the last header signed by the sequencer in store.
+const LastSignedHeaderKey = "last signed header"
+
+// signGuard protects the sequencer from signing conflicting headers.
+//
+// Like the state file of CometBFT private validators, the last signed header is saved before the
+// signature is released, so the guard refuses to sign a header below the last signed height, or a
+// different header at the same height, even after a restart. Unlike the state file, it's kept in
+// the store, so it applies to all signers, including remote signers without their own protection.
+type signGuard struct {
+	store store.Store
+
+	mtx    sync.Mutex
+	height uint64
+	hash   types.Hash
+}
+
+// newSignGuard returns a guard loading the last signed header from the store.
+func newSignGuard(ctx context.Context, s store.Store) (*signGuard, error) {
+	g := &signGuard{store: s}
+	raw, err := s.GetMetadata(ctx, LastSignedHeaderKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		// nothing was signed yet
+		return g, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) < 8 {
+		return nil, fmt.Errorf("invalid last signed header: %X", raw)
+	}
+	g.height = binary.BigEndian.Uint64(raw[:8])
+	g.hash = raw[8:]
+	return g, nil
+}
+
+// check returns an error if the header conflicts with the last signed header. Otherwise the header
+// is saved as the last signed header.
+func (g *signGuard) check(ctx context.Context, header *types.Header) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	height, hash := header.Height(), header.Hash()
+	switch {
+	case height < g.height:
+		// headers above the height of the state were signed, but rolled back (see ResetSignState)
+		return fmt.Errorf("%w: got %d, last signed height %d; if blocks were rolled back, sign state has to be reset",
+			signer.ErrHeightRegression, height, g.height)
+	case height == g.height:
+		if !bytes.Equal(hash, g.hash) {
+			return fmt.Errorf("%w: header %s was signed at height %d", signer.ErrConflictingData, g.hash, height)
+		}
+		return nil
+	}
+
+	raw := make([]byte, 8, 8+len(hash))
+	binary.BigEndian.PutUint64(raw, height)
+	raw = append(raw, hash...)
+	if err := g.store.SetMetadata(ctx, LastSignedHeaderKey, raw); err != nil {
+		return fmt.Errorf("failed to save last signed header: %w", err)
+	}
+	g.height, g.hash = height, hash
+	return nil
+}
+
+// lastSignedHeight returns the height of the last signed header.
+func (g *signGuard) lastSignedHeight() uint64 {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.height
+}
+
+// LastSignedHeight returns the height of the last header signed by the sequencer, saved in the store.
+func LastSignedHeight(ctx context.Context, s store.Store) (uint64, error) {
+	g, err := newSignGuard(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+	return g.height, nil
+}
+
+// ResetSignState lowers the last signed header saved in the store to the header stored at given
+// height, so that the sequencer signs headers above it again. Headers above the height must have
+// been rolled back and never published, as signing different headers at their heights is double
+// signing. Sign state of the signer (e.g. the state file of LocalSigner) has to be reset separately.
+func ResetSignState(ctx context.Context, s store.Store, height uint64) error {
+	g, err := newSignGuard(ctx, s)
+	if err != nil {
+		return err
+	}
+	if g.height <= height {
+		return nil
+	}
+	raw := binary.BigEndian.AppendUint64(nil, height)
+	// nothing is stored below the initial height
+	if height > 0 {
+		header, _, err := s.GetBlockData(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to load block at height %d: %w", height, err)
+		}
+		raw = append(raw, header.Hash()...)
+	}
+	return s.SetMetadata(ctx, LastSignedHeaderKey, raw)
+}
@@ -0,0 +1,86 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestSignGuard(t *testing.T) {
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+
+	g, err := newSignGuard(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), g.lastSignedHeight())
+
+	header, _ := types.GetRandomBlock(2, 1)
+	conflicting, _ := types.GetRandomBlock(2, 1)
+	lower, _ := types.GetRandomBlock(1, 1)
+	require.NotEqual(t, header.Hash(), conflicting.Hash())
+
+	require.NoError(t, g.check(ctx, &header.Header))
+	assert.Equal(t, uint64(2), g.lastSignedHeight())
+	// the same header can be signed again
+	require.NoError(t, g.check(ctx, &header.Header))
+	assert.ErrorIs(t, g.check(ctx, &conflicting.Header), signer.ErrConflictingData)
+	assert.ErrorIs(t, g.check(ctx, &lower.Header), signer.ErrHeightRegression)
+
+	// the last signed header is persisted
+	g, err = newSignGuard(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), g.lastSignedHeight())
+	require.NoError(t, g.check(ctx, &header.Header))
+	assert.ErrorIs(t, g.check(ctx, &conflicting.Header), signer.ErrConflictingData)
+
+	next, _ := types.GetRandomBlock(3, 1)
+	require.NoError(t, g.check(ctx, &next.Header))
+	assert.Equal(t, uint64(3), g.lastSignedHeight())
+}
+
+func TestResetSignState(t *testing.T) {
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+
+	g, err := newSignGuard(ctx, s)
+	require.NoError(t, err)
+	stored, data := types.GetRandomBlock(2, 1)
+	require.NoError(t, s.SaveBlockData(ctx, stored, data, &stored.Signature))
+	signed, _ := types.GetRandomBlock(3, 1)
+	require.NoError(t, g.check(ctx, &signed.Header))
+
+	// sign state is never raised
+	require.NoError(t, ResetSignState(ctx, s, 4))
+	height, err := LastSignedHeight(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), height)
+
+	require.NoError(t, ResetSignState(ctx, s, 2))
+	height, err = LastSignedHeight(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), height)
+	g, err = newSignGuard(ctx, s)
+	require.NoError(t, err)
+	// the stored header is recorded as signed, a conflicting one isn't signed
+	require.NoError(t, g.check(ctx, &stored.Header))
+	conflicting, _ := types.GetRandomBlock(2, 1)
+	assert.ErrorIs(t, g.check(ctx, &conflicting.Header), signer.ErrConflictingData)
+	other, _ := types.GetRandomBlock(3, 1)
+	require.NoError(t, g.check(ctx, &other.Header))
+
+	require.NoError(t, ResetSignState(ctx, s, 0))
+	g, err = newSignGuard(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), g.lastSignedHeight())
+	require.NoError(t, g.check(ctx, &conflicting.Header))
+}
@@ -0,0 +1,67 @@
+package block
+
+import (
+	"context"
+	"time"
+)
+
+// WaitForPromotion blocks until the standby aggregator has to take over block production.
+//
+// The active aggregator is considered down when the highest height known from the P2P network and
+// DA doesn't change for StandbyMissedBlocks block times. The standby aggregator is promoted only
+// once it has synced all known blocks, so that it continues the chain from its tip.
+func (m *Manager) WaitForPromotion(ctx context.Context) error {
+	if !m.isProposer {
+		return ErrNotProposer
+	}
+	m.standby.Store(true)
+
+	blockTime := m.conf.BlockTime
+	if m.conf.LazyAggregator {
+		blockTime = m.conf.LazyBlockTime
+	}
+	timeout := time.Duration(m.conf.StandbyMissedBlocks) * blockTime //nolint:gosec
+
+	ticker := time.NewTicker(blockTime)
+	defer ticker.Stop()
+	targetHeight := m.GetSyncStatus().TargetHeight
+	lastProgress := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.haltCh:
+			return m.HaltReason()
+		case <-ticker.C:
+		}
+		status := m.GetSyncStatus()
+		if status.TargetHeight > targetHeight {
+			targetHeight = status.TargetHeight
+			lastProgress = time.Now()
+			continue
+		}
+		if time.Since(lastProgress) < timeout {
+			continue
+		}
+		if status.BlocksBehind > 0 {
+			m.logger.Info("Active aggregator is down, syncing before promotion",
+				"height", status.Height, "targetHeight", status.TargetHeight)
+			continue
+		}
+		m.logger.Info("Active aggregator is down, promoting standby aggregator",
+			"height", status.Height, "lastProgress", lastProgress)
+		return nil
+	}
+}
+
+// Promote makes the standby aggregator the active one. The sync loops have to be stopped before
+// calling Promote, and the aggregation loop started after it; the aggregation loop takes over block
+// production from the state synced from the network once it acquires the lease (see CheckSetup).
+func (m *Manager) Promote() {
+	m.standby.Store(false)
+}
+
+// IsStandby returns true if the node is a standby aggregator that was not promoted yet.
+func (m *Manager) IsStandby() bool {
+	return m.standby.Load()
+}
@@ -0,0 +1,90 @@
+package block
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	test "github.com/rollkit/rollkit/test/log"
+)
+
+func getStandbyManager(t *testing.T) *Manager {
+	t.Helper()
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	s := store.New(kvStore)
+	saveTestChain(t, s, 3)
+
+	logger := test.NewLogger(t)
+	pendingHeaders, err := NewPendingHeaders(s, logger)
+	require.NoError(t, err)
+	signGuard, err := newSignGuard(ctx, s)
+	require.NoError(t, err)
+	return &Manager{
+		store:          s,
+		lastStateMtx:   new(sync.RWMutex),
+		pendingHeaders: pendingHeaders,
+		signGuard:      signGuard,
+		logger:         logger,
+		isProposer:     true,
+		lease:          &testLease{},
+		conf: config.BlockManagerConfig{
+			BlockTime:           10 * time.Millisecond,
+			Standby:             true,
+			StandbyMissedBlocks: 3,
+		},
+	}
+}
+
+func TestStandbyPromotion(t *testing.T) {
+	ctx := context.Background()
+	m := getStandbyManager(t)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.NoError(t, m.WaitForPromotion(ctx))
+	assert.True(t, m.IsStandby())
+
+	m.Promote()
+	assert.False(t, m.IsStandby())
+	// the aggregation loop takes over block production once the lease is acquired
+	require.NoError(t, m.acquireLease(ctx))
+	assert.Equal(t, uint64(3), m.lastState.LastBlockHeight)
+	// synced headers can't be signed over
+	assert.Equal(t, uint64(3), m.signGuard.lastSignedHeight())
+}
+
+func TestStandbyNotPromotedWhenBehind(t *testing.T) {
+	m := getStandbyManager(t)
+	// a block known from DA is not synced yet
+	m.setDABlockHeight(4)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.WaitForPromotion(ctx), context.DeadlineExceeded)
+	// standby aggregator reports catching up, unlike the active one
+	assert.True(t, m.GetSyncStatus().CatchingUp)
+}
+
+func TestStandbyNotProposer(t *testing.T) {
+	m := getStandbyManager(t)
+	m.isProposer = false
+	assert.ErrorIs(t, m.WaitForPromotion(context.Background()), ErrNotProposer)
+}
+
+func TestStandbyRequiresLease(t *testing.T) {
+	m := getStandbyManager(t)
+	require.NoError(t, m.CheckSetup())
+	m.SetLease(nil)
+	assert.ErrorIs(t, m.CheckSetup(), ErrStandbyWithoutLease)
+	// standby nodes without the sequencer key are never promoted
+	m.isProposer = false
+	assert.NoError(t, m.CheckSetup())
+}
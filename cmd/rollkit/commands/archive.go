@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	cometnode "github.com/cometbft/cometbft/node"
+	cometproxy "github.com/cometbft/cometbft/proxy"
+	"github.com/spf13/cobra"
+
+	rollconf "github.com/rollkit/rollkit/config"
+	rollnode "github.com/rollkit/rollkit/node"
+)
+
+const (
+	flagExportFrom   = "from"
+	flagExportTo     = "to"
+	flagExportOutput = "output"
+	flagImportInput  = "input"
+	flagImportExec   = "execute"
+)
+
+// NewExportCmd returns the command that exports blocks of the node to a chain archive.
+func NewExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export blocks of the node to a chain archive",
+		Long: `
+Export writes blocks of the node, with their signatures and execution results, to a versioned and
+checksummed chain archive. The archive can be imported by another node of the same chain with the
+import command, e.g. to seed a new node or to recover from a disaster.
+
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			from, err := cmd.Flags().GetUint64(flagExportFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(flagExportTo)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagExportOutput)
+			if err != nil {
+				return err
+			}
+			rollconf.GetNodeConfig(&nodeConfig, config)
+
+			w := cmd.OutOrStdout()
+			if output != "-" {
+				f, err := os.Create(output) //nolint:gosec
+				if err != nil {
+					return err
+				}
+				defer func() {
+					err = errors.Join(err, f.Close())
+				}()
+				w = f
+			}
+			bw := bufio.NewWriter(w)
+			if err := rollnode.ExportBlocks(cmd.Context(), nodeConfig, bw, from, to); err != nil {
+				return fmt.Errorf("failed to export blocks: %w", err)
+			}
+			return bw.Flush()
+		},
+	}
+	cmd.Flags().Uint64(flagExportFrom, 1, "height of the first block to export")
+	cmd.Flags().Uint64(flagExportTo, 0, "height of the last block to export (0 for the latest block)")
+	cmd.Flags().StringP(flagExportOutput, "o", "-", "path of the archive file (- for standard output)")
+	return cmd
+}
+
+// NewImportCmd returns the command that imports blocks from a chain archive into the node.
+func NewImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import blocks from a chain archive into the node",
+		Long: `
+Import reads a chain archive created by the export command and saves its blocks in the store of the
+node. Every block is validated and must be signed by the sequencer of the genesis. Blocks already
+in the store are skipped.
+
+With --execute, blocks are executed against the application and their results must match the
+archived ones. Otherwise, archived results are trusted, and application state has to be restored
+separately, to the height reported by the command.
+
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			input, err := cmd.Flags().GetString(flagImportInput)
+			if err != nil {
+				return err
+			}
+			execute, err := cmd.Flags().GetBool(flagImportExec)
+			if err != nil {
+				return err
+			}
+			genDoc, err := cometnode.DefaultGenesisDocProviderFunc(config)()
+			if err != nil {
+				return err
+			}
+			rollconf.GetNodeConfig(&nodeConfig, config)
+
+			var r io.Reader = cmd.InOrStdin()
+			if input != "-" {
+				f, err := os.Open(input) //nolint:gosec
+				if err != nil {
+					return err
+				}
+				defer func() {
+					err = errors.Join(err, f.Close())
+				}()
+				r = f
+			}
+			var clientCreator cometproxy.ClientCreator
+			if execute {
+				clientCreator = cometproxy.DefaultClientCreator(config.ProxyApp, config.ABCI, nodeConfig.DBPath)
+			}
+
+			st, err := rollnode.ImportBlocks(cmd.Context(), nodeConfig, genDoc, r, clientCreator, logger)
+			if err != nil {
+				return fmt.Errorf("failed to import blocks (last imported height %d): %w", st.LastBlockHeight, err)
+			}
+			cmd.Printf("Imported blocks up to height %d and hash %s\n", st.LastBlockHeight, st.AppHash)
+			if !execute {
+				cmd.Printf("Application state has to be restored to height %d\n", st.LastBlockHeight)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP(flagImportInput, "i", "-", "path of the archive file (- for standard input)")
+	cmd.Flags().Bool(flagImportExec, false, "execute imported blocks against the application")
+	cmd.Flags().String("proxy_app", config.ProxyApp, "address of the application to execute blocks against")
+	return cmd
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"time"
+
+	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cometconf "github.com/cometbft/cometbft/config"
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cometos "github.com/cometbft/cometbft/libs/os"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	rollconf "github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/keys"
+	rollsigner "github.com/rollkit/rollkit/signer"
+)
+
+const (
+	flagInitChainID      = "chain_id"
+	flagInitSequencerKey = "sequencer_key"
+	flagSignerTimeout    = "signer_timeout"
+	flagInitForce        = "force"
+)
+
+// NewInitCmd returns the command that initializes configuration, keys and genesis of a node.
+func NewInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Initialize configuration, keys and genesis of the node",
+		Long: `
+Init writes the config file of the node, holding CometBFT and Rollkit configuration set with flags
+(e.g. DA address and namespace, block times, sequencer address, P2P seeds), generates missing
+private validator and node keys, and writes a genesis file with the given chain ID.
+
+The sequencer of the genesis is the private validator of the node, unless an existing private
+validator key file is given with --sequencer_key, or a remote signer is used. With
+--priv_validator_laddr, init listens on the address and waits for the remote signer to connect
+to get its public key.
+
+The start command reads the config file; as DA and sequencer addresses are set in it, start
+connects to them instead of launching mock services. Init fails if the genesis file exists,
+unless --force is used.
+`,
+		Example: `  rollkit init --chain_id my-rollup --rollkit.da_address http://localhost:26658 --rollkit.da_namespace 0102030405060708`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			chainID, err := cmd.Flags().GetString(flagInitChainID)
+			if err != nil {
+				return err
+			}
+			sequencerKey, err := cmd.Flags().GetString(flagInitSequencerKey)
+			if err != nil {
+				return err
+			}
+			signerTimeout, err := cmd.Flags().GetDuration(flagSignerTimeout)
+			if err != nil {
+				return err
+			}
+			force, err := cmd.Flags().GetBool(flagInitForce)
+			if err != nil {
+				return err
+			}
+			if sequencerKey != "" && config.PrivValidatorListenAddr != "" {
+				return errors.New("sequencer key file and remote signer can't be used together")
+			}
+			if chainID == "" {
+				chainID = fmt.Sprintf("test-rollup-%08x", rand.Uint32()) //nolint:gosec
+			}
+			// nodes are aggregators by default, as in the start command
+			if !viper.IsSet(rollconf.FlagAggregator) {
+				nodeConfig.Aggregator = true
+			}
+			if err := rollconf.ValidateNamespace(nodeConfig.DANamespace); err != nil {
+				return fmt.Errorf("invalid DA namespace: %w", err)
+			}
+			genFile := config.GenesisFile()
+			if cometos.FileExists(genFile) && !force {
+				return fmt.Errorf("genesis file %s already exists, use --%s to overwrite it", genFile, flagInitForce)
+			}
+
+			var pubKey cometcrypto.PubKey
+			switch {
+			case sequencerKey != "":
+				if pubKey, err = importPrivValidator(sequencerKey); err != nil {
+					return err
+				}
+			case config.PrivValidatorListenAddr != "":
+				if pubKey, err = remoteSignerPubKey(chainID, signerTimeout); err != nil {
+					return err
+				}
+			default:
+				if pubKey, err = initPrivValidator(); err != nil {
+					return fmt.Errorf("can't get pubkey: %w", err)
+				}
+			}
+			if err := initNodeKey(); err != nil {
+				return err
+			}
+			if err := writeGenesis(genFile, chainID, pubKey); err != nil {
+				return err
+			}
+
+			configFile := filepath.Join(config.RootDir, cometconf.DefaultConfigDir, cometconf.DefaultConfigFileName)
+			if err := rollconf.WriteConfigFile(configFile, config, nodeConfig); err != nil {
+				return fmt.Errorf("failed to write config file: %w", err)
+			}
+			logger.Info("Generated config file", "path", configFile)
+			return nil
+		},
+	}
+
+	cmtcmd.AddNodeFlags(cmd)
+	rollconf.AddFlags(cmd)
+	cmd.Flags().String(flagInitChainID, "", "chain ID of the genesis (random test chain ID if empty)")
+	cmd.Flags().String(flagInitSequencerKey, "", "existing private validator key file of the sequencer")
+	cmd.Flags().Duration(flagSignerTimeout, 30*time.Second, "time to wait for the remote signer to connect")
+	cmd.Flags().Bool(flagInitForce, false, "overwrite existing genesis and config files")
+	return cmd
+}
+
+// importPrivValidator configures the node to use the private validator key file at keyFile, which
+// may be encrypted.
+func importPrivValidator(keyFile string) (cometcrypto.PubKey, error) {
+	keyFile, err := filepath.Abs(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !cometos.FileExists(keyFile) {
+		return nil, fmt.Errorf("private validator key file %s doesn't exist", keyFile)
+	}
+	pubKey, err := keys.ReadPubKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config.PrivValidatorKey = keyFile
+	logger.Info("Using private validator", "keyFile", keyFile)
+	return pubKey, nil
+}
+
+// remoteSignerPubKey waits for the remote signer to connect to the private validator listen
+// address and returns its public key.
+func remoteSignerPubKey(chainID string, timeout time.Duration) (cometcrypto.PubKey, error) {
+	logger.Info("Waiting for remote signer", "address", config.PrivValidatorListenAddr)
+	client, err := rollsigner.NewRemoteSigner(config.PrivValidatorListenAddr, chainID, timeout, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close() //nolint:errcheck
+	pubKey, err := client.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pubkey from remote signer: %w", err)
+	}
+	logger.Info("Using remote signer", "address", pubKey.Address())
+	return pubKey, nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	cometconf "github.com/cometbft/cometbft/config"
+	cometos "github.com/cometbft/cometbft/libs/os"
+	cometprivval "github.com/cometbft/cometbft/privval"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rollconf "github.com/rollkit/rollkit/config"
+)
+
+func TestInitCmd(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	home := t.TempDir()
+	t.Setenv("RKHOME", home)
+	t.Cleanup(func() {
+		viper.Reset()
+		config = cometconf.DefaultConfig()
+		nodeConfig = rollconf.DefaultNodeConfig
+	})
+
+	runInit := func(args ...string) error {
+		cmd := NewInitCmd()
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	assert.ErrorContains(runInit("--rollkit.da_namespace", "namespace"), "invalid DA namespace")
+	assert.False(cometos.FileExists(filepath.Join(home, "config", "genesis.json")))
+
+	require.NoError(runInit(
+		"--chain_id", "test-chain",
+		"--rollkit.da_address", "http://127.0.0.1:27005",
+		"--rollkit.da_namespace", "0102030405060708",
+		"--rollkit.block_time", "2s",
+		"--p2p.seeds", "/ip4/127.0.0.1/tcp/7676/p2p/12D3KooW",
+	))
+	genDoc, err := comettypes.GenesisDocFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(err)
+	assert.Equal("test-chain", genDoc.ChainID)
+	pv := cometprivval.LoadFilePV(filepath.Join(home, "config", "priv_validator_key.json"), filepath.Join(home, "data", "priv_validator_state.json"))
+	require.Len(genDoc.Validators, 1)
+	assert.Equal(pv.GetAddress(), genDoc.Validators[0].Address)
+	assert.True(cometos.FileExists(filepath.Join(home, "config", "node_key.json")))
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(v.ReadInConfig())
+	var written rollconf.NodeConfig
+	require.NoError(written.GetViperConfig(v))
+	assert.True(written.Aggregator)
+	assert.Equal("http://127.0.0.1:27005", written.DAAddress)
+	assert.Equal("0102030405060708", written.DANamespace)
+	assert.Equal(2*time.Second, written.BlockTime)
+	assert.Equal("/ip4/127.0.0.1/tcp/7676/p2p/12D3KooW", v.GetString("p2p.seeds"))
+
+	// existing genesis is kept unless forced
+	assert.ErrorContains(runInit("--chain_id", "other-chain"), "already exists")
+
+	// existing private validator of another node is used as the sequencer
+	otherKeyFile := filepath.Join(t.TempDir(), "key.json")
+	other := cometprivval.GenFilePV(otherKeyFile, "")
+	other.Key.Save()
+	require.NoError(runInit("--chain_id", "other-chain", "--force", "--sequencer_key", otherKeyFile))
+	genDoc, err = comettypes.GenesisDocFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(err)
+	assert.Equal("other-chain", genDoc.ChainID)
+	assert.Equal(other.GetAddress(), genDoc.Validators[0].Address)
+	require.NoError(v.ReadInConfig())
+	assert.Equal(otherKeyFile, v.GetString("priv_validator_key_file"))
+	// configuration from the config file is kept
+	assert.Equal("0102030405060708", v.GetString("rollkit.da_namespace"))
+}
@@ -0,0 +1,272 @@
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/block"
+	rollconf "github.com/rollkit/rollkit/config"
+	rollnode "github.com/rollkit/rollkit/node"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+const (
+	flagInspectOutput = "output"
+	flagInspectFrom   = "from"
+	flagInspectTo     = "to"
+)
+
+// NewInspectCmd returns the command family that inspects the database of a stopped node.
+func NewInspectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect the database of the node",
+		Long: `
+Inspect opens the database of the node in read-only mode and prints the state, blocks, metadata
+and pending headers saved in it, or checks its consistency. The node must be stopped.
+`,
+	}
+	cmd.PersistentFlags().StringP(flagInspectOutput, "o", "text", "output format (text or json)")
+	cmd.AddCommand(
+		newInspectStateCmd(),
+		newInspectBlockCmd(),
+		newInspectMetadataCmd(),
+		newInspectPendingCmd(),
+		newInspectCheckCmd(),
+	)
+	return cmd
+}
+
+func newInspectStateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "state",
+		Short: "Print the state of the node",
+		Args:  cobra.NoArgs,
+		RunE: inspectRunE(func(cmd *cobra.Command, args []string, s *store.DefaultStore) error {
+			st, err := s.GetState(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to load state: %w", err)
+			}
+			return printInspected(cmd, st, func(w io.Writer) {
+				fmt.Fprintf(w, "Chain ID:\t%s\n", st.ChainID)
+				fmt.Fprintf(w, "Initial height:\t%d\n", st.InitialHeight)
+				fmt.Fprintf(w, "Last block height:\t%d\n", st.LastBlockHeight)
+				fmt.Fprintf(w, "Last block time:\t%s\n", st.LastBlockTime)
+				fmt.Fprintf(w, "Last block hash:\t%X\n", st.LastBlockID.Hash)
+				fmt.Fprintf(w, "App hash:\t%s\n", st.AppHash)
+				fmt.Fprintf(w, "Last results hash:\t%s\n", st.LastResultsHash)
+				fmt.Fprintf(w, "DA height:\t%d\n", st.DAHeight)
+				if st.Validators != nil {
+					fmt.Fprintf(w, "Validators:\t%d\n", st.Validators.Size())
+				}
+			})
+		}),
+	}
+}
+
+// inspectedBlock is a block saved in the store, with its signature and responses.
+type inspectedBlock struct {
+	Hash      types.Hash                  `json:"hash"`
+	Header    *types.SignedHeader         `json:"header"`
+	Data      *types.Data                 `json:"data"`
+	Signature cmbytes.HexBytes            `json:"signature"`
+	Responses *abci.ResponseFinalizeBlock `json:"responses"`
+}
+
+func newInspectBlockCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "block <height|hash>",
+		Short: "Print the block at given height or with given hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: inspectRunE(func(cmd *cobra.Command, args []string, s *store.DefaultStore) error {
+			ctx := cmd.Context()
+			var (
+				header *types.SignedHeader
+				data   *types.Data
+				err    error
+			)
+			if height, parseErr := strconv.ParseUint(args[0], 10, 64); parseErr == nil {
+				header, data, err = s.GetBlockData(ctx, height)
+			} else {
+				hash, decodeErr := hex.DecodeString(args[0])
+				if decodeErr != nil {
+					return fmt.Errorf("invalid block height or hash %q", args[0])
+				}
+				header, data, err = s.GetBlockByHash(ctx, hash)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load block: %w", err)
+			}
+			result := inspectedBlock{Hash: header.Hash(), Header: header, Data: data}
+			if signature, err := s.GetSignature(ctx, header.Height()); err == nil {
+				result.Signature = cmbytes.HexBytes(*signature)
+			}
+			if responses, err := s.GetBlockResponses(ctx, header.Height()); err == nil {
+				result.Responses = responses
+			}
+			return printInspected(cmd, result, func(w io.Writer) {
+				fmt.Fprintf(w, "Height:\t%d\n", header.Height())
+				fmt.Fprintf(w, "Hash:\t%s\n", result.Hash)
+				fmt.Fprintf(w, "Time:\t%s\n", header.Time())
+				fmt.Fprintf(w, "Chain ID:\t%s\n", header.ChainID())
+				fmt.Fprintf(w, "Proposer:\t%X\n", header.ProposerAddress)
+				fmt.Fprintf(w, "Last header hash:\t%s\n", header.LastHeaderHash)
+				fmt.Fprintf(w, "Data hash:\t%s\n", header.DataHash)
+				fmt.Fprintf(w, "App hash:\t%s\n", header.AppHash)
+				fmt.Fprintf(w, "Last results hash:\t%s\n", header.LastResultsHash)
+				fmt.Fprintf(w, "Transactions:\t%d\n", len(data.Txs))
+				fmt.Fprintf(w, "Signature:\t%X\n", result.Signature)
+				if result.Responses != nil {
+					fmt.Fprintf(w, "Responses:\t%d tx results, app hash %X\n", len(result.Responses.TxResults), result.Responses.AppHash)
+				} else {
+					fmt.Fprintf(w, "Responses:\tmissing\n")
+				}
+			})
+		}),
+	}
+}
+
+func newInspectMetadataCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata",
+		Short: "Print metadata saved by the node",
+		Args:  cobra.NoArgs,
+		RunE: inspectRunE(func(cmd *cobra.Command, args []string, s *store.DefaultStore) error {
+			entries, err := block.InspectMetadata(cmd.Context(), s)
+			if err != nil {
+				return fmt.Errorf("failed to load metadata: %w", err)
+			}
+			return printInspected(cmd, entries, func(w io.Writer) {
+				for _, entry := range entries {
+					fmt.Fprintf(w, "%s:\t%s\n", entry.Key, entry.Value)
+				}
+			})
+		}),
+	}
+}
+
+func newInspectPendingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Short: "Print the range of headers not submitted to DA yet",
+		Args:  cobra.NoArgs,
+		RunE: inspectRunE(func(cmd *cobra.Command, args []string, s *store.DefaultStore) error {
+			pending, err := block.InspectPendingHeaders(cmd.Context(), s)
+			if err != nil {
+				return err
+			}
+			return printInspected(cmd, pending, func(w io.Writer) {
+				if pending.Count == 0 {
+					fmt.Fprintln(w, "No pending headers")
+					return
+				}
+				fmt.Fprintf(w, "%d pending headers, heights %d to %d\n", pending.Count, pending.FromHeight, pending.ToHeight)
+			})
+		}),
+	}
+}
+
+func newInspectCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check consistency of blocks saved by the node",
+		Long: `
+Check verifies that every height is mapped to a block with matching hash and height, and that
+block data and signatures are saved. By default, all blocks up to the height of the state are
+checked. The command fails if inconsistencies are found.
+`,
+		Args: cobra.NoArgs,
+		RunE: inspectRunE(func(cmd *cobra.Command, args []string, s *store.DefaultStore) error {
+			from, err := cmd.Flags().GetUint64(flagInspectFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetUint64(flagInspectTo)
+			if err != nil {
+				return err
+			}
+			if from == 0 || to == 0 {
+				st, err := s.GetState(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("failed to load state: %w", err)
+				}
+				if from == 0 {
+					from = st.InitialHeight
+				}
+				if to == 0 {
+					to = st.LastBlockHeight
+				}
+			}
+			problems, err := s.CheckConsistency(cmd.Context(), from, to)
+			if err != nil {
+				return err
+			}
+			err = printInspected(cmd, problems, func(w io.Writer) {
+				if len(problems) == 0 {
+					fmt.Fprintf(w, "No inconsistencies found in blocks %d to %d\n", from, to)
+				}
+				for _, problem := range problems {
+					fmt.Fprintf(w, "%d:\t%s\n", problem.Height, problem.Problem)
+				}
+			})
+			if err == nil && len(problems) > 0 {
+				err = fmt.Errorf("found %d inconsistencies", len(problems))
+			}
+			return err
+		}),
+	}
+	cmd.Flags().Uint64(flagInspectFrom, 0, "height of the first block to check (0 for the initial height)")
+	cmd.Flags().Uint64(flagInspectTo, 0, "height of the last block to check (0 for the height of the state)")
+	return cmd
+}
+
+// inspectRunE opens the store of the node for inspection and closes it after run.
+func inspectRunE(run func(cmd *cobra.Command, args []string, s *store.DefaultStore) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		if err := parseConfig(cmd); err != nil {
+			return err
+		}
+		rollconf.GetNodeConfig(&nodeConfig, config)
+		s, err := rollnode.OpenReadOnlyStore(nodeConfig)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, s.Close())
+		}()
+		return run(cmd, args, s)
+	}
+}
+
+// printInspected prints v as JSON, or as text written by text, depending on the output flag.
+func printInspected(cmd *cobra.Command, v interface{}, text func(w io.Writer)) error {
+	output, err := cmd.Flags().GetString(flagInspectOutput)
+	if err != nil {
+		return err
+	}
+	switch output {
+	case "json":
+		bz, err := cmtjson.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+		return err
+	case "text":
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		text(w)
+		return w.Flush()
+	default:
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+}
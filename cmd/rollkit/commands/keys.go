@@ -0,0 +1,387 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cometcrypto "github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cometos "github.com/cometbft/cometbft/libs/os"
+	cometp2p "github.com/cometbft/cometbft/p2p"
+	cometprivval "github.com/cometbft/cometbft/privval"
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/keys"
+)
+
+const (
+	keyKindSequencer = "sequencer"
+	keyKindP2P       = "p2p"
+
+	flagKeysType     = "type"
+	flagKeysMnemonic = "mnemonic"
+	flagKeysAccount  = "account"
+	flagKeysIndex    = "index"
+	flagKeysEncrypt  = "encrypt"
+	flagKeysForce    = "force"
+	flagKeysUnsafe   = "unsafe"
+
+	// envPassphrase is the environment variable holding the passphrase of encrypted keys. If it's
+	// not set, the passphrase is read from the standard input.
+	envPassphrase = "RKPASSPHRASE"
+)
+
+// NewKeysCmd returns the command family that manages the sequencer and P2P keys of the node.
+func NewKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the sequencer and P2P keys of the node",
+		Long: `
+Keys generates, imports, exports and shows the keys of the node. Each subcommand takes the kind of
+the key: "sequencer" for the key signing blocks (the private validator key file), or "p2p" for
+the key identifying the node in the P2P network (the node key file).
+
+Keys are ed25519 or secp256k1 keys, and can be derived from a BIP-39 mnemonic. With --encrypt,
+key files are encrypted with a passphrase, which is read from the ` + envPassphrase + `
+environment variable, or from the standard input. The start command asks for the passphrase of
+encrypted key files the same way.
+`,
+	}
+	cmd.PersistentFlags().StringP(flagInspectOutput, "o", "text", "output format (text or json)")
+	cmd.AddCommand(
+		newKeysGenerateCmd(),
+		newKeysImportCmd(),
+		newKeysExportCmd(),
+		newKeysShowCmd(),
+	)
+	return cmd
+}
+
+func newKeysGenerateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [sequencer|p2p]",
+		Short: "Generate a new key",
+		Long: `
+Generate generates a new key and writes it to the key file. With --mnemonic, a new mnemonic is
+generated and printed, and the key is derived from it; the mnemonic is the only way to recover
+the key, keep it safe.
+`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{keyKindSequencer, keyKindP2P},
+		RunE: keysRunE(func(cmd *cobra.Command, p *prompter, kind, file string) error {
+			keyType, err := cmd.Flags().GetString(flagKeysType)
+			if err != nil {
+				return err
+			}
+			withMnemonic, err := cmd.Flags().GetBool(flagKeysMnemonic)
+			if err != nil {
+				return err
+			}
+			if err := checkKeyFile(cmd, file); err != nil {
+				return err
+			}
+			var privKey cometcrypto.PrivKey
+			if withMnemonic {
+				mnemonic, err := keys.NewMnemonic()
+				if err != nil {
+					return err
+				}
+				if privKey, err = keys.FromMnemonic(mnemonic, keyType, 0, 0); err != nil {
+					return err
+				}
+				cmd.PrintErrln("Mnemonic of the key, write it down and keep it safe:")
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), mnemonic); err != nil {
+					return err
+				}
+			} else if privKey, err = keys.GenerateKey(keyType); err != nil {
+				return err
+			}
+			return writeKey(cmd, p, kind, file, privKey)
+		}),
+	}
+	cmd.Flags().String(flagKeysType, keys.KeyTypeEd25519, "key type (ed25519 or secp256k1)")
+	cmd.Flags().Bool(flagKeysMnemonic, false, "derive the key from a new mnemonic, printed to the output")
+	addKeyWriteFlags(cmd)
+	return cmd
+}
+
+func newKeysImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [sequencer|p2p] [file]",
+		Short: "Import a key from a file or a mnemonic",
+		Long: `
+Import reads a key from a CometBFT private validator key or node key file, or from a key exported
+by the export command, and writes it to the key file. With --mnemonic, the key is derived from a
+BIP-39 mnemonic read from the standard input instead, on the path m/44'/118'/account'/0/index for
+secp256k1 keys, and m/44'/118'/account'/0'/index' for ed25519 keys.
+`,
+		Example:   `  rollkit keys import sequencer priv_validator_key.json --encrypt`,
+		Args:      cobra.RangeArgs(1, 2),
+		ValidArgs: []string{keyKindSequencer, keyKindP2P},
+		RunE: keysRunE(func(cmd *cobra.Command, p *prompter, kind, file string) error {
+			withMnemonic, err := cmd.Flags().GetBool(flagKeysMnemonic)
+			if err != nil {
+				return err
+			}
+			args := cmd.Flags().Args()
+			if withMnemonic == (len(args) == 2) {
+				return errors.New("either a key file or --mnemonic must be given")
+			}
+			if err := checkKeyFile(cmd, file); err != nil {
+				return err
+			}
+			var privKey cometcrypto.PrivKey
+			if withMnemonic {
+				privKey, err = readMnemonicKey(cmd, p)
+			} else {
+				privKey, err = keys.ReadPrivKey(args[1], func() (string, error) {
+					return p.passphrase("Enter passphrase of the imported key: ")
+				})
+			}
+			if err != nil {
+				return err
+			}
+			return writeKey(cmd, p, kind, file, privKey)
+		}),
+	}
+	cmd.Flags().Bool(flagKeysMnemonic, false, "derive the key from a mnemonic read from the standard input")
+	cmd.Flags().String(flagKeysType, keys.KeyTypeEd25519, "type of the key derived from the mnemonic (ed25519 or secp256k1)")
+	cmd.Flags().Uint32(flagKeysAccount, 0, "account of the key derived from the mnemonic")
+	cmd.Flags().Uint32(flagKeysIndex, 0, "index of the key derived from the mnemonic")
+	addKeyWriteFlags(cmd)
+	return cmd
+}
+
+func newKeysExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [sequencer|p2p]",
+		Short: "Export a key",
+		Long: `
+Export prints the key encrypted with a passphrase, in a format read by the import command. With
+--unsafe, the key is printed unencrypted, as a CometBFT private validator key or node key file.
+`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{keyKindSequencer, keyKindP2P},
+		RunE: keysRunE(func(cmd *cobra.Command, p *prompter, kind, file string) error {
+			unsafe, err := cmd.Flags().GetBool(flagKeysUnsafe)
+			if err != nil {
+				return err
+			}
+			privKey, err := keys.ReadPrivKey(file, func() (string, error) {
+				return p.passphrase("Enter passphrase of the key file: ")
+			})
+			if err != nil {
+				return err
+			}
+			var out string
+			if unsafe {
+				var v interface{} = cometp2p.NodeKey{PrivKey: privKey}
+				if kind == keyKindSequencer {
+					v = cometprivval.FilePVKey{Address: privKey.PubKey().Address(), PubKey: privKey.PubKey(), PrivKey: privKey}
+				}
+				bz, err := cmtjson.MarshalIndent(v, "", "  ")
+				if err != nil {
+					return err
+				}
+				out = string(bz)
+			} else {
+				passphrase, err := p.newPassphrase("Enter passphrase of the exported key: ")
+				if err != nil {
+					return err
+				}
+				if out, err = keys.Encrypt(privKey, passphrase); err != nil {
+					return err
+				}
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out)
+			return err
+		}),
+	}
+	cmd.Flags().Bool(flagKeysUnsafe, false, "export the key unencrypted")
+	return cmd
+}
+
+func newKeysShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:       "show [sequencer|p2p]",
+		Short:     "Show the public key, address and peer ID of a key",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{keyKindSequencer, keyKindP2P},
+		RunE: keysRunE(func(cmd *cobra.Command, p *prompter, kind, file string) error {
+			pubKey, err := keys.ReadPubKey(file)
+			if err != nil {
+				return err
+			}
+			return printKeyInfo(cmd, kind, file, pubKey)
+		}),
+	}
+}
+
+// keysRunE parses the config and the key kind argument, and calls run with the key file of the kind.
+func keysRunE(run func(cmd *cobra.Command, p *prompter, kind, file string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := parseConfig(cmd); err != nil {
+			return err
+		}
+		var file string
+		switch args[0] {
+		case keyKindSequencer:
+			file = config.PrivValidatorKeyFile()
+		case keyKindP2P:
+			file = config.NodeKeyFile()
+		default:
+			return fmt.Errorf("unknown key kind %q, expected %s or %s", args[0], keyKindSequencer, keyKindP2P)
+		}
+		return run(cmd, newPrompter(cmd.InOrStdin(), cmd.ErrOrStderr()), args[0], file)
+	}
+}
+
+func addKeyWriteFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagKeysEncrypt, false, "encrypt the key file with a passphrase")
+	cmd.Flags().Bool(flagKeysForce, false, "overwrite the existing key file")
+}
+
+// checkKeyFile returns an error if file exists and --force isn't used.
+func checkKeyFile(cmd *cobra.Command, file string) error {
+	force, err := cmd.Flags().GetBool(flagKeysForce)
+	if err != nil {
+		return err
+	}
+	if cometos.FileExists(file) && !force {
+		return fmt.Errorf("key file %s already exists, use --%s to overwrite it", file, flagKeysForce)
+	}
+	return nil
+}
+
+// readMnemonicKey reads a mnemonic and derives the key set by the flags from it.
+func readMnemonicKey(cmd *cobra.Command, p *prompter) (cometcrypto.PrivKey, error) {
+	keyType, err := cmd.Flags().GetString(flagKeysType)
+	if err != nil {
+		return nil, err
+	}
+	account, err := cmd.Flags().GetUint32(flagKeysAccount)
+	if err != nil {
+		return nil, err
+	}
+	index, err := cmd.Flags().GetUint32(flagKeysIndex)
+	if err != nil {
+		return nil, err
+	}
+	mnemonic, err := p.readLine("Enter mnemonic: ")
+	if err != nil {
+		return nil, err
+	}
+	return keys.FromMnemonic(mnemonic, keyType, account, index)
+}
+
+// writeKey writes privKey to the key file of kind, encrypted if --encrypt is used, and prints it.
+func writeKey(cmd *cobra.Command, p *prompter, kind, file string, privKey cometcrypto.PrivKey) error {
+	encrypt, err := cmd.Flags().GetBool(flagKeysEncrypt)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return err
+	}
+	switch {
+	case encrypt:
+		passphrase, err := p.newPassphrase("Enter passphrase of the key file: ")
+		if err != nil {
+			return err
+		}
+		if err := keys.WriteEncrypted(file, privKey, passphrase); err != nil {
+			return err
+		}
+	case kind == keyKindSequencer:
+		cometprivval.NewFilePV(privKey, file, config.PrivValidatorStateFile()).Key.Save()
+	default:
+		if err := (&cometp2p.NodeKey{PrivKey: privKey}).SaveAs(file); err != nil {
+			return err
+		}
+	}
+	return printKeyInfo(cmd, kind, file, privKey.PubKey())
+}
+
+// keyInfo is the description of a key printed by the keys commands.
+type keyInfo struct {
+	Kind    string             `json:"kind"`
+	File    string             `json:"file"`
+	Type    string             `json:"type"`
+	Address string             `json:"address"`
+	PubKey  cometcrypto.PubKey `json:"pub_key"`
+	PeerID  string             `json:"peer_id"`
+}
+
+func printKeyInfo(cmd *cobra.Command, kind, file string, pubKey cometcrypto.PubKey) error {
+	info, err := keys.Describe(pubKey)
+	if err != nil {
+		return err
+	}
+	v := keyInfo{Kind: kind, File: file, Type: info.Type, Address: info.Address, PubKey: info.PubKey, PeerID: info.PeerID}
+	return printInspected(cmd, v, func(w io.Writer) {
+		fmt.Fprintf(w, "Kind:\t%s\n", kind)
+		fmt.Fprintf(w, "File:\t%s\n", file)
+		fmt.Fprintf(w, "Type:\t%s\n", info.Type)
+		fmt.Fprintf(w, "Address:\t%s\n", info.Address)
+		fmt.Fprintf(w, "Public key:\t%X\n", info.PubKey.Bytes())
+		fmt.Fprintf(w, "Peer ID:\t%s\n", info.PeerID)
+	})
+}
+
+// prompter reads secrets, one per line, from an input.
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func newPrompter(in io.Reader, out io.Writer) *prompter {
+	return &prompter{in: bufio.NewReader(in), out: out}
+}
+
+// readLine prints prompt and reads a line.
+func (p *prompter) readLine(prompt string) (string, error) {
+	fmt.Fprint(p.out, prompt)
+	line, err := p.in.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// passphrase returns the passphrase set in the environment, or reads it.
+func (p *prompter) passphrase(prompt string) (string, error) {
+	if passphrase, ok := os.LookupEnv(envPassphrase); ok {
+		return passphrase, nil
+	}
+	return p.readLine(prompt)
+}
+
+// newPassphrase is like passphrase, but asks to repeat read passphrases, and rejects empty ones.
+func (p *prompter) newPassphrase(prompt string) (string, error) {
+	if passphrase, ok := os.LookupEnv(envPassphrase); ok {
+		if passphrase == "" {
+			return "", errors.New("passphrase must not be empty")
+		}
+		return passphrase, nil
+	}
+	passphrase, err := p.readLine(prompt)
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", errors.New("passphrase must not be empty")
+	}
+	repeated, err := p.readLine("Repeat passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if repeated != passphrase {
+		return "", errors.New("passphrases don't match")
+	}
+	return passphrase, nil
+}
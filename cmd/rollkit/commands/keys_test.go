@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cometconf "github.com/cometbft/cometbft/config"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cometp2p "github.com/cometbft/cometbft/p2p"
+	cometprivval "github.com/cometbft/cometbft/privval"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rollconf "github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/keys"
+)
+
+func TestKeysCmd(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	home := t.TempDir()
+	t.Setenv("RKHOME", home)
+	t.Cleanup(func() {
+		viper.Reset()
+		config = cometconf.DefaultConfig()
+		nodeConfig = rollconf.DefaultNodeConfig
+	})
+	pvKeyFile := filepath.Join(home, "config", "priv_validator_key.json")
+	nodeKeyFile := filepath.Join(home, "config", "node_key.json")
+
+	runKeys := func(input string, args ...string) (string, error) {
+		var out bytes.Buffer
+		cmd := NewKeysCmd()
+		cmd.SetArgs(args)
+		cmd.SetIn(strings.NewReader(input))
+		cmd.SetOut(&out)
+		cmd.SetErr(&bytes.Buffer{})
+		err := cmd.Execute()
+		return out.String(), err
+	}
+	showKey := func(kind string) keyInfo {
+		out, err := runKeys("", "show", kind, "-o", "json")
+		require.NoError(err)
+		var info keyInfo
+		require.NoError(cmtjson.Unmarshal([]byte(out), &info))
+		return info
+	}
+
+	// plain sequencer key, readable by CometBFT
+	_, err := runKeys("", "generate", "sequencer", "--type", "secp256k1")
+	require.NoError(err)
+	pv := cometprivval.LoadFilePVEmptyState(pvKeyFile, "")
+	assert.Equal(keys.KeyTypeSecp256k1, pv.Key.PrivKey.Type())
+	info := showKey("sequencer")
+	assert.Equal(pv.Key.Address.String(), info.Address)
+	assert.Equal(pvKeyFile, info.File)
+
+	_, err = runKeys("", "generate", "sequencer")
+	assert.ErrorContains(err, "already exists")
+	_, err = runKeys("", "generate", "validator")
+	assert.ErrorContains(err, "unknown key kind")
+
+	// encrypted P2P key derived from a mnemonic
+	out, err := runKeys("secret\nsecret\n", "generate", "p2p", "--mnemonic", "--encrypt")
+	require.NoError(err)
+	mnemonic := strings.SplitN(out, "\n", 2)[0]
+	assert.Len(strings.Fields(mnemonic), 24)
+	bz, err := os.ReadFile(nodeKeyFile) //nolint:gosec
+	require.NoError(err)
+	assert.True(keys.IsEncrypted(bz))
+	p2pInfo := showKey("p2p")
+	assert.NotEmpty(p2pInfo.PeerID)
+
+	_, err = runKeys("secret\nother\n", "generate", "p2p", "--encrypt", "--force")
+	assert.ErrorContains(err, "passphrases don't match")
+
+	// the same key is imported from the mnemonic
+	_, err = runKeys(mnemonic+"\n", "import", "p2p", "--mnemonic", "--force")
+	require.NoError(err)
+	assert.Equal(p2pInfo, showKey("p2p"))
+	nodeKey, err := cometp2p.LoadNodeKey(nodeKeyFile)
+	require.NoError(err)
+	assert.Equal(p2pInfo.Address, nodeKey.PrivKey.PubKey().Address().String())
+
+	_, err = runKeys("", "import", "p2p", "--force")
+	assert.ErrorContains(err, "either a key file or --mnemonic")
+
+	// export and import of the encrypted sequencer key
+	t.Setenv(envPassphrase, "exported")
+	exported, err := runKeys("", "export", "sequencer")
+	require.NoError(err)
+	assert.True(keys.IsEncrypted([]byte(exported)))
+	exportFile := filepath.Join(t.TempDir(), "exported")
+	require.NoError(os.WriteFile(exportFile, []byte(exported), 0o600))
+
+	_, err = runKeys("", "generate", "sequencer", "--force")
+	require.NoError(err)
+	assert.NotEqual(info.Address, showKey("sequencer").Address)
+	_, err = runKeys("", "import", "sequencer", exportFile, "--force", "--encrypt")
+	require.NoError(err)
+	assert.Equal(info.Address, showKey("sequencer").Address)
+
+	unsafe, err := runKeys("", "export", "sequencer", "--unsafe")
+	require.NoError(err)
+	require.NoError(os.WriteFile(exportFile, []byte(unsafe), 0o600))
+	pv = cometprivval.LoadFilePVEmptyState(exportFile, "")
+	assert.Equal(info.Address, pv.Key.Address.String())
+
+	t.Setenv(envPassphrase, "wrong")
+	_, err = runKeys("", "export", "sequencer")
+	assert.ErrorIs(err, keys.ErrWrongPassphrase)
+}
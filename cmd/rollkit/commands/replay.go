@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	cometnode "github.com/cometbft/cometbft/node"
+	cometproxy "github.com/cometbft/cometbft/proxy"
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/block"
+	rollconf "github.com/rollkit/rollkit/config"
+	rollnode "github.com/rollkit/rollkit/node"
+)
+
+const (
+	flagReplayFrom          = "from"
+	flagReplayTo            = "to"
+	flagReplayDiffResponses = "diff_responses"
+)
+
+// NewReplayCmd returns the command that replays blocks of the node against the application.
+func NewReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay blocks of the node against the application",
+		Long: `
+Replay executes blocks saved by the node against an application and compares the resulting app
+hash and last results hash of every block with the ones saved in the following header. It can be
+used after an upgrade of the application, or to track down non-determinism.
+
+The application has to be fresh, or restored to the height preceding the first replayed block.
+With --diff_responses, the responses of the application are also compared with the saved ones.
+Replay stops at the first diverging block, and the command fails.
+
+The node must be stopped; its database is not modified.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			var opts block.ReplayOptions
+			var err error
+			if opts.From, err = cmd.Flags().GetUint64(flagReplayFrom); err != nil {
+				return err
+			}
+			if opts.To, err = cmd.Flags().GetUint64(flagReplayTo); err != nil {
+				return err
+			}
+			if opts.DiffResponses, err = cmd.Flags().GetBool(flagReplayDiffResponses); err != nil {
+				return err
+			}
+			genDoc, err := cometnode.DefaultGenesisDocProviderFunc(config)()
+			if err != nil {
+				return err
+			}
+			rollconf.GetNodeConfig(&nodeConfig, config)
+
+			clientCreator := cometproxy.DefaultClientCreator(config.ProxyApp, config.ABCI, nodeConfig.DBPath)
+			result, err := rollnode.ReplayBlocks(cmd.Context(), nodeConfig, genDoc, clientCreator, opts, logger)
+			if err != nil {
+				return fmt.Errorf("failed to replay blocks: %w", err)
+			}
+			err = printInspected(cmd, result, func(w io.Writer) {
+				if result.Divergence == nil {
+					fmt.Fprintf(w, "Replayed blocks %d to %d without divergence\n", result.From, result.To)
+					return
+				}
+				fmt.Fprintf(w, "Results of block %d diverge from the saved results:\n", result.Divergence.Height)
+				for _, problem := range result.Divergence.Problems {
+					fmt.Fprintf(w, "  %s\n", problem)
+				}
+			})
+			if err == nil && result.Divergence != nil {
+				err = fmt.Errorf("block %d diverges", result.Divergence.Height)
+			}
+			return err
+		},
+	}
+	cmd.Flags().Uint64(flagReplayFrom, 0, "height of the first block to replay (0 to start after the last block of the application)")
+	cmd.Flags().Uint64(flagReplayTo, 0, "height of the last block to replay (0 for the latest block)")
+	cmd.Flags().Bool(flagReplayDiffResponses, false, "compare responses of the application with the saved responses")
+	cmd.Flags().StringP(flagInspectOutput, "o", "text", "output format (text or json)")
+	cmd.Flags().String("proxy_app", config.ProxyApp, "address of the application to replay blocks against")
+	return cmd
+}
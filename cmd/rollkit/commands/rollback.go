@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	cometnode "github.com/cometbft/cometbft/node"
+	"github.com/spf13/cobra"
+
+	"github.com/rollkit/rollkit/block"
+	rollconf "github.com/rollkit/rollkit/config"
+	rollnode "github.com/rollkit/rollkit/node"
+)
+
+const (
+	flagRollbackBlocks = "blocks"
+	flagResetSignState = "reset-sign-state"
+)
+
+// NewRollbackCmd returns the command that rolls back the state of the node by the given number of blocks.
+//
+// Application state is rolled back by app. If app is nil, application state has to be rolled back
+// separately, to the height reported by the command.
+func NewRollbackCmd(app block.AppRollbacker) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the state of the node by the given number of blocks",
+		Long: `
+Rollback removes the latest blocks from the store of the node and restores the state after
+the latest remaining block. It can be used to recover from a bad block or an incorrect
+application state transition: after restart, the removed blocks are synced and executed again.
+
+The node must be stopped. The store is rolled back before application state. Unless the command
+is provided by the application, or rolling back the application fails, application state has to
+be rolled back separately, to the height reported by the command.
+
+The height and hash of the last header signed by the sequencer are kept, so an aggregator refuses
+to produce blocks at rolled back heights it has signed before. If the rolled back headers were never
+published, --reset-sign-state lowers the sign state, in the store and in the private validator state
+file, to the height of the rolled back state. Signing different headers at heights of published
+headers is double signing. Remote signers have to be reset separately.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := parseConfig(cmd); err != nil {
+				return err
+			}
+			blocks, err := cmd.Flags().GetUint64(flagRollbackBlocks)
+			if err != nil {
+				return err
+			}
+			genDoc, err := cometnode.DefaultGenesisDocProviderFunc(config)()
+			if err != nil {
+				return err
+			}
+			rollconf.GetNodeConfig(&nodeConfig, config)
+
+			st, err := rollnode.Rollback(cmd.Context(), nodeConfig, genDoc, blocks, app)
+			if err != nil && !errors.Is(err, rollnode.ErrAppRollback) {
+				return fmt.Errorf("failed to rollback state: %w", err)
+			}
+			// the store is rolled back first, so application state may have to be rolled back
+			// manually even if the application supports rollback
+			cmd.Printf("Rolled back state to height %d and hash %s\n", st.LastBlockHeight, st.AppHash)
+			if app == nil || err != nil {
+				cmd.Printf("Application state has to be rolled back to height %d\n", st.LastBlockHeight)
+			}
+			if err != nil {
+				return err
+			}
+			return reportSignState(cmd, st.LastBlockHeight)
+		},
+	}
+	cmd.Flags().Uint64(flagRollbackBlocks, 1, "number of blocks to rollback")
+	cmd.Flags().Bool(flagResetSignState, false, "lower the last signed header to the rolled back height (only if rolled back headers were never published)")
+	return cmd
+}
+
+// reportSignState reports if the sequencer signed headers above the rolled back height, and resets
+// sign state if requested.
+func reportSignState(cmd *cobra.Command, height uint64) error {
+	signed, err := rollnode.LastSignedHeight(cmd.Context(), nodeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load sign state: %w", err)
+	}
+	if signed <= height {
+		return nil
+	}
+	reset, err := cmd.Flags().GetBool(flagResetSignState)
+	if err != nil {
+		return err
+	}
+	if !reset {
+		cmd.Printf("Sequencer signed headers up to height %d, so an aggregator fails to produce blocks at lower heights (height regression); use --%s if the rolled back headers were never published\n",
+			signed, flagResetSignState)
+		return nil
+	}
+	if err := rollnode.ResetSignState(cmd.Context(), nodeConfig, config.PrivValidatorStateFile(), height); err != nil {
+		return err
+	}
+	cmd.Printf("Reset sign state from height %d to height %d\n", signed, height)
+	return nil
+}
@@ -2,15 +2,18 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
 	"time"
 
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cometconf "github.com/cometbft/cometbft/config"
+	cometcrypto "github.com/cometbft/cometbft/crypto"
 	cometcli "github.com/cometbft/cometbft/libs/cli"
 	cometflags "github.com/cometbft/cometbft/libs/cli/flags"
 	cometlog "github.com/cometbft/cometbft/libs/log"
@@ -33,8 +36,11 @@ import (
 	"github.com/spf13/viper"
 
 	rollconf "github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/keys"
 	rollnode "github.com/rollkit/rollkit/node"
 	rollrpc "github.com/rollkit/rollkit/rpc"
+	rollrpcjson "github.com/rollkit/rollkit/rpc/json"
+	rollsigner "github.com/rollkit/rollkit/signer"
 	rolltypes "github.com/rollkit/rollkit/types"
 )
 
@@ -52,6 +58,16 @@ var (
 // MockSequencerAddress is a sample address used by the mock sequencer
 const MockSequencerAddress = "localhost:50051"
 
+const (
+	// ExitCodeHalted is the exit code when the node halted at the halt height or on request of the
+	// application (see block.ErrHalted), so that a process manager can start an upgraded binary.
+	ExitCodeHalted = 3
+
+	// ExitCodeAppFailed is the exit code when the node stopped because the application failed to
+	// execute a block (see block.ErrAppFailed), which has to be investigated before restarting.
+	ExitCodeAppFailed = 4
+)
+
 // NewRunNodeCmd returns the command that allows the CLI to start a node.
 func NewRunNodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -65,8 +81,8 @@ func NewRunNodeCmd() *cobra.Command {
 				return err
 			}
 
-			// use aggregator by default if the flag is not specified explicitly
-			if !cmd.Flags().Lookup("rollkit.aggregator").Changed {
+			// use aggregator by default if it's not specified explicitly
+			if !viper.IsSet(rollconf.FlagAggregator) {
 				nodeConfig.Aggregator = true
 			}
 
@@ -97,18 +113,42 @@ func NewRunNodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			nodeKey, err := cometp2p.LoadOrGenNodeKey(config.NodeKeyFile())
+			p := newPrompter(cmd.InOrStdin(), cmd.ErrOrStderr())
+			nodeKey, err := keys.ReadPrivKey(config.NodeKeyFile(), func() (string, error) {
+				return p.passphrase("Enter passphrase of the node key: ")
+			})
 			if err != nil {
 				return err
 			}
-			pval := cometprivval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
-			p2pKey, err := rolltypes.GetNodeKey(nodeKey)
+			p2pKey, err := rolltypes.GetNodeKey(&cometp2p.NodeKey{PrivKey: nodeKey})
 			if err != nil {
 				return err
 			}
-			signingKey, err := rolltypes.GetNodeKey(&cometp2p.NodeKey{PrivKey: pval.Key.PrivKey})
-			if err != nil {
-				return err
+
+			// use the remote signer if one is configured, the private validator key otherwise
+			var signer rollsigner.Signer
+			if config.PrivValidatorListenAddr != "" {
+				timeout, err := cmd.Flags().GetDuration(flagSignerTimeout)
+				if err != nil {
+					return err
+				}
+				logger.Info("Waiting for remote signer", "address", config.PrivValidatorListenAddr)
+				client, err := rollsigner.NewRemoteSigner(config.PrivValidatorListenAddr, genDoc.ChainID, timeout, logger)
+				if err != nil {
+					return err
+				}
+				defer client.Close() //nolint:errcheck
+				signer = client
+			} else {
+				pvKey, err := keys.ReadPrivKey(config.PrivValidatorKeyFile(), func() (string, error) {
+					return p.passphrase("Enter passphrase of the private validator key: ")
+				})
+				if err != nil {
+					return err
+				}
+				if signer, err = rollsigner.NewLocalSigner(pvKey, config.PrivValidatorStateFile()); err != nil {
+					return err
+				}
 			}
 
 			// default to socket connections for remote clients
@@ -126,7 +166,7 @@ func NewRunNodeCmd() *cobra.Command {
 			metrics := rollnode.DefaultMetricsProvider(cometconf.DefaultInstrumentationConfig())
 
 			// use mock jsonrpc da server by default
-			if !cmd.Flags().Lookup("rollkit.da_address").Changed {
+			if !viper.IsSet(rollconf.FlagDAAddress) {
 				srv, err := startMockDAServJSONRPC(cmd.Context())
 				if err != nil {
 					return fmt.Errorf("failed to launch mock da server: %w", err)
@@ -136,7 +176,7 @@ func NewRunNodeCmd() *cobra.Command {
 			}
 
 			// use mock grpc sequencer server by default
-			if !cmd.Flags().Lookup("rollkit.sequencer_address").Changed {
+			if !viper.IsSet(rollconf.FlagSequencerAddress) {
 				srv, err := startMockSequencerServerGRPC(MockSequencerAddress)
 				if err != nil {
 					return fmt.Errorf("failed to launch mock sequencing server: %w", err)
@@ -145,8 +185,8 @@ func NewRunNodeCmd() *cobra.Command {
 				defer func() { srv.Stop() }()
 			}
 
-			// use noop proxy app by default
-			if !cmd.Flags().Lookup("proxy_app").Changed {
+			// use noop proxy app by default, unless another address is configured
+			if !cmd.Flags().Lookup("proxy_app").Changed && config.ProxyApp == cometconf.DefaultBaseConfig().ProxyApp {
 				config.ProxyApp = "noop"
 			}
 
@@ -155,7 +195,7 @@ func NewRunNodeCmd() *cobra.Command {
 				context.Background(),
 				nodeConfig,
 				p2pKey,
-				signingKey,
+				signer,
 				cometproxy.DefaultClientCreator(config.ProxyApp, config.ABCI, nodeConfig.DBPath),
 				genDoc,
 				metrics,
@@ -166,7 +206,18 @@ func NewRunNodeCmd() *cobra.Command {
 			}
 
 			// Launch the RPC server
-			server := rollrpc.NewServer(rollnode, config.RPC, logger)
+			rpcMetrics := rollrpcjson.NopMetrics()
+			accessMetrics := rollrpc.NopMetrics()
+			if config.Instrumentation.IsPrometheusEnabled() {
+				rpcMetrics = rollrpcjson.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", genDoc.ChainID)
+				accessMetrics = rollrpc.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", genDoc.ChainID)
+			}
+			accessControl, err := rollrpc.NewAccessControl(nodeConfig.RPC, accessMetrics)
+			if err != nil {
+				return fmt.Errorf("invalid RPC access control configuration: %w", err)
+			}
+			server := rollrpc.NewServer(rollnode, config.RPC, logger, rollrpcjson.WithMetrics(rpcMetrics))
+			server.SetAccessControl(accessControl)
 			err = server.Start()
 			if err != nil {
 				return fmt.Errorf("failed to launch RPC server: %w", err)
@@ -195,8 +246,19 @@ func NewRunNodeCmd() *cobra.Command {
 				return err
 			}
 			if !inCI {
-				// Block forever to force user to stop node
-				select {}
+				// Block until the node halts, or forever to force user to stop node
+				halter, ok := rollnode.(interface {
+					Halted() <-chan struct{}
+					HaltReason() error
+				})
+				if !ok {
+					select {}
+				}
+				<-halter.Halted()
+				if err := rollnode.Stop(); err != nil {
+					logger.Error("unable to stop the node", "error", err)
+				}
+				return halter.HaltReason()
 			}
 
 			// CI mode. Wait for 5s and then verify the node is running before calling stop node.
@@ -230,6 +292,7 @@ func addNodeFlags(cmd *cobra.Command) {
 
 	cmd.Flags().String("transport", config.ABCI, "specify abci transport (socket | grpc)")
 	cmd.Flags().Bool("ci", false, "run node for ci testing")
+	cmd.Flags().Duration(flagSignerTimeout, 30*time.Second, "time to wait for the remote signer to connect")
 
 	// Add Rollkit flags
 	rollconf.AddFlags(cmd)
@@ -260,62 +323,76 @@ func startMockSequencerServerGRPC(listenAddress string) (*grpc.Server, error) {
 	return server, nil
 }
 
-// TODO (Ferret-san): modify so that it initiates files with rollkit configurations by default
-// note that such a change would also require changing the cosmos-sdk
+// initFiles generates the private validator, node key and genesis files missing in the root
+// directory. Generated genesis has a random chain ID; use the init command to choose one.
 func initFiles() error {
-	// Generate the private validator config files
+	pubKey, err := initPrivValidator()
+	if err != nil {
+		return err
+	}
+	if err := initNodeKey(); err != nil {
+		return err
+	}
+
+	// Generate the genesis file
+	genFile := config.GenesisFile()
+	if cometos.FileExists(genFile) {
+		logger.Info("Found genesis file", "path", genFile)
+		return nil
+	}
+	return writeGenesis(genFile, fmt.Sprintf("test-rollup-%08x", rand.Uint32()), pubKey) //nolint:gosec
+}
+
+// initPrivValidator generates the private validator if the key file is missing, and returns its
+// public key. The key file may be encrypted.
+func initPrivValidator() (cometcrypto.PubKey, error) {
 	cometprivvalKeyFile := config.PrivValidatorKeyFile()
 	cometprivvalStateFile := config.PrivValidatorStateFile()
-	var pv *cometprivval.FilePV
 	if cometos.FileExists(cometprivvalKeyFile) {
-		pv = cometprivval.LoadFilePV(cometprivvalKeyFile, cometprivvalStateFile)
-		logger.Info("Found private validator", "keyFile", cometprivvalKeyFile,
-			"stateFile", cometprivvalStateFile)
-	} else {
-		pv = cometprivval.GenFilePV(cometprivvalKeyFile, cometprivvalStateFile)
-		pv.Save()
-		logger.Info("Generated private validator", "keyFile", cometprivvalKeyFile,
-			"stateFile", cometprivvalStateFile)
+		logger.Info("Found private validator", "keyFile", cometprivvalKeyFile)
+		return keys.ReadPubKey(cometprivvalKeyFile)
 	}
+	pv := cometprivval.GenFilePV(cometprivvalKeyFile, cometprivvalStateFile)
+	pv.Save()
+	logger.Info("Generated private validator", "keyFile", cometprivvalKeyFile,
+		"stateFile", cometprivvalStateFile)
+	return pv.GetPubKey()
+}
 
-	// Generate the node key config files
+// initNodeKey generates the node key if the key file is missing.
+func initNodeKey() error {
 	nodeKeyFile := config.NodeKeyFile()
 	if cometos.FileExists(nodeKeyFile) {
 		logger.Info("Found node key", "path", nodeKeyFile)
-	} else {
-		if _, err := cometp2p.LoadOrGenNodeKey(nodeKeyFile); err != nil {
-			return err
-		}
-		logger.Info("Generated node key", "path", nodeKeyFile)
+		return nil
+	}
+	if _, err := cometp2p.LoadOrGenNodeKey(nodeKeyFile); err != nil {
+		return err
 	}
+	logger.Info("Generated node key", "path", nodeKeyFile)
+	return nil
+}
 
-	// Generate the genesis file
-	genFile := config.GenesisFile()
-	if cometos.FileExists(genFile) {
-		logger.Info("Found genesis file", "path", genFile)
-	} else {
-		genDoc := comettypes.GenesisDoc{
-			ChainID:         fmt.Sprintf("test-rollup-%08x", rand.Uint32()), //nolint:gosec
-			GenesisTime:     comettime.Now(),
-			ConsensusParams: comettypes.DefaultConsensusParams(),
-		}
-		pubKey, err := pv.GetPubKey()
-		if err != nil {
-			return fmt.Errorf("can't get pubkey: %w", err)
-		}
-		genDoc.Validators = []comettypes.GenesisValidator{{
+// writeGenesis writes the genesis file with given chain ID and sequencer key.
+func writeGenesis(genFile string, chainID string, pubKey cometcrypto.PubKey) error {
+	genDoc := comettypes.GenesisDoc{
+		ChainID:         chainID,
+		GenesisTime:     comettime.Now(),
+		ConsensusParams: comettypes.DefaultConsensusParams(),
+		Validators: []comettypes.GenesisValidator{{
 			Address: pubKey.Address(),
 			PubKey:  pubKey,
 			Power:   1000,
 			Name:    "Rollkit Sequencer",
-		}}
-
-		if err := genDoc.SaveAs(genFile); err != nil {
-			return err
-		}
-		logger.Info("Generated genesis file", "path", genFile)
+		}},
 	}
-
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return fmt.Errorf("invalid genesis: %w", err)
+	}
+	if err := genDoc.SaveAs(genFile); err != nil {
+		return err
+	}
+	logger.Info("Generated genesis file", "path", genFile, "chainID", chainID)
 	return nil
 }
 
@@ -334,6 +411,11 @@ func parseConfig(cmd *cobra.Command) error {
 	// Validate the root directory
 	cometconf.EnsureRoot(config.RootDir)
 
+	// Read the config file, values passed as flags take precedence
+	if err := readConfigFile(filepath.Join(config.RootDir, cometconf.DefaultConfigDir, cometconf.DefaultConfigFileName)); err != nil {
+		return fmt.Errorf("error reading config file: %w", err)
+	}
+
 	// Validate the config
 	if err := config.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in config file: %w", err)
@@ -347,6 +429,18 @@ func parseConfig(cmd *cobra.Command) error {
 	return nil
 }
 
+// readConfigFile reads the config file into viper. A missing config file is not an error, defaults
+// and flags are used instead.
+func readConfigFile(path string) error {
+	viper.SetConfigFile(path)
+	err := viper.ReadInConfig()
+	var notFound viper.ConfigFileNotFoundError
+	if errors.As(err, &notFound) || errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
 func parseFlags(cmd *cobra.Command) error {
 	v := viper.GetViper()
 	if err := v.BindPFlags(cmd.Flags()); err != nil {
@@ -366,7 +460,7 @@ func parseFlags(cmd *cobra.Command) error {
 	}
 
 	// special handling for the p2p external address, due to inconsistencies in mapstructure and flag name
-	if cmd.Flags().Lookup("p2p.external-address").Changed {
+	if flag := cmd.Flags().Lookup("p2p.external-address"); flag != nil && flag.Changed {
 		config.P2P.ExternalAddress = viper.GetString("p2p.external-address")
 	}
 
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -133,3 +135,20 @@ func TestAggregatorFlagInvariants(t *testing.T) {
 		}
 	}
 }
+
+func TestReadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+
+	// defaults and flags are used without the config file
+	if err := readConfigFile(filepath.Join(dir, "config.toml")); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.toml")
+	if err := os.WriteFile(invalid, []byte("not = toml = file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := readConfigFile(invalid); err == nil {
+		t.Error("Expected error for invalid config file")
+	}
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/cometbft/cometbft/libs/cli"
 
+	"github.com/rollkit/rollkit/block"
 	cmd "github.com/rollkit/rollkit/cmd/rollkit/commands"
 	rollconf "github.com/rollkit/rollkit/config"
 )
@@ -18,10 +20,17 @@ func main() {
 	// Add subcommands to the root command
 	rootCmd.AddCommand(
 		cmd.DocsGenCmd,
+		cmd.NewInitCmd(),
 		cmd.NewRunNodeCmd(),
 		cmd.VersionCmd,
 		cmd.NewTomlCmd(),
 		cmd.RebuildCmd,
+		cmd.NewRollbackCmd(nil),
+		cmd.NewExportCmd(),
+		cmd.NewImportCmd(),
+		cmd.NewInspectCmd(),
+		cmd.NewReplayCmd(),
+		cmd.NewKeysCmd(),
 	)
 
 	// In case there is a rollkit.toml file in the current dir or somewhere up the
@@ -45,6 +54,12 @@ func main() {
 	if err := executor.Execute(); err != nil {
 		// Print to stderr and exit with error
 		fmt.Fprintln(os.Stderr, err)
+		switch {
+		case errors.Is(err, block.ErrHalted):
+			os.Exit(cmd.ExitCodeHalted)
+		case errors.Is(err, block.ErrAppFailed):
+			os.Exit(cmd.ExitCodeAppFailed)
+		}
 		os.Exit(1)
 	}
 }
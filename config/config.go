@@ -42,6 +42,73 @@ const (
 	FlagLazyBlockTime = "rollkit.lazy_block_time"
 	// FlagSequencerAddress is a flag for specifying the sequencer middleware address
 	FlagSequencerAddress = "rollkit.sequencer_address"
+	// FlagIntermediateStateRoots is a flag for enabling generation and verification of intermediate state roots
+	FlagIntermediateStateRoots = "rollkit.intermediate_state_roots"
+	// FlagRequireCommittee is a flag for requiring headers attested by the committee of validators
+	FlagRequireCommittee = "rollkit.require_committee"
+	// FlagEquivocationPolicy is a flag for specifying the reaction to sequencer equivocation
+	FlagEquivocationPolicy = "rollkit.equivocation_policy"
+	// FlagDAConflictPolicy is a flag for specifying the reaction to P2P blocks contradicted by DA
+	FlagDAConflictPolicy = "rollkit.da_conflict_policy"
+	// FlagLeaseFile is a flag for specifying the file locked by the aggregator while producing blocks
+	FlagLeaseFile = "rollkit.lease_file"
+	// FlagStandby is a flag for running aggregator as a standby for the active aggregator
+	FlagStandby = "rollkit.standby"
+	// FlagStandbyMissedBlocks is a flag for specifying the number of block times without new headers after which standby aggregator is promoted
+	FlagStandbyMissedBlocks = "rollkit.standby_missed_blocks"
+	// FlagHaltHeight is a flag for specifying the height of the last block before the node halts
+	FlagHaltHeight = "rollkit.halt_height"
+	// FlagHaltTime is a flag for specifying the block time (in Unix seconds) at which the node halts
+	FlagHaltTime = "rollkit.halt_time"
+	// FlagCatchingUpThreshold is a flag for specifying the number of blocks behind at which node is catching up
+	FlagCatchingUpThreshold = "rollkit.catching_up_threshold"
+	// FlagLegacyCatchingUp is a flag for always reporting catching_up as false in node status
+	FlagLegacyCatchingUp = "rollkit.legacy_catching_up"
+	// FlagHealthMaxBlockAge is a flag for specifying the maximum age of the latest block reported as healthy
+	FlagHealthMaxBlockAge = "rollkit.health_max_block_age"
+	// FlagHealthMinPeers is a flag for specifying the minimum number of P2P peers reported as ready
+	FlagHealthMinPeers = "rollkit.health_min_peers"
+	// FlagHealthMaxIndexerLag is a flag for specifying the maximum number of blocks not yet indexed reported as ready
+	FlagHealthMaxIndexerLag = "rollkit.health_max_indexer_lag"
+	// FlagHealthCheckTimeout is a flag for specifying the timeout of a single health check
+	FlagHealthCheckTimeout = "rollkit.health_check_timeout"
+	// FlagRPCAuthTokens is a flag for specifying bearer tokens allowing calls to protected RPC methods
+	FlagRPCAuthTokens = "rollkit.rpc_auth_tokens" // #nosec G101
+	// FlagRPCJWTSecret is a flag for specifying the secret used to verify JWT bearer tokens
+	FlagRPCJWTSecret = "rollkit.rpc_jwt_secret" // #nosec G101
+	// FlagRPCProtectedMethods is a flag for specifying RPC methods requiring authentication
+	FlagRPCProtectedMethods = "rollkit.rpc_protected_methods"
+	// FlagRPCAllowedMethods is a flag for specifying RPC methods allowed to be called
+	FlagRPCAllowedMethods = "rollkit.rpc_allowed_methods"
+	// FlagRPCDeniedMethods is a flag for specifying RPC methods not allowed to be called
+	FlagRPCDeniedMethods = "rollkit.rpc_denied_methods"
+	// FlagRPCRateLimit is a flag for specifying the number of RPC calls per second allowed from a single IP address
+	FlagRPCRateLimit = "rollkit.rpc_rate_limit"
+	// FlagRPCRateLimitBurst is a flag for specifying the number of RPC calls from a single IP address allowed at once
+	FlagRPCRateLimitBurst = "rollkit.rpc_rate_limit_burst"
+	// FlagRPCTokenRateLimit is a flag for specifying the number of RPC calls per second allowed with a single token
+	FlagRPCTokenRateLimit = "rollkit.rpc_token_rate_limit"
+	// FlagRPCTokenRateLimitBurst is a flag for specifying the number of RPC calls with a single token allowed at once
+	FlagRPCTokenRateLimitBurst = "rollkit.rpc_token_rate_limit_burst"
+)
+
+const (
+	// EquivocationPolicyHalt stops the node when sequencer equivocation is detected.
+	EquivocationPolicyHalt = "halt"
+	// EquivocationPolicyAlert only reports sequencer equivocation and keeps the node running.
+	EquivocationPolicyAlert = "alert"
+
+	// DAConflictPolicyHalt stops the node when a block applied from P2P network is contradicted by DA.
+	DAConflictPolicyHalt = "halt"
+	// DAConflictPolicyRollback rolls back the contradicted blocks and re-syncs them from DA.
+	DAConflictPolicyRollback = "rollback"
+
+	// IndexerKV indexes transactions and blocks in the node's key-value store.
+	IndexerKV = "kv"
+	// IndexerPsql writes transactions, blocks and their events to a PostgreSQL database.
+	IndexerPsql = "psql"
+	// IndexerNull disables indexing.
+	IndexerNull = "null"
 )
 
 // NodeConfig stores Rollkit node configuration.
@@ -58,13 +125,22 @@ type NodeConfig struct {
 	DAAuthToken        string `mapstructure:"da_auth_token"`
 	Light              bool   `mapstructure:"light"`
 	HeaderConfig       `mapstructure:",squash"`
+	HealthConfig       `mapstructure:",squash"`
 	Instrumentation    *cmcfg.InstrumentationConfig `mapstructure:"instrumentation"`
 	DAGasPrice         float64                      `mapstructure:"da_gas_price"`
 	DAGasMultiplier    float64                      `mapstructure:"da_gas_multiplier"`
+	// Indexer selects the indexer of transactions and blocks: IndexerKV (default), IndexerPsql or IndexerNull.
+	Indexer string `mapstructure:"indexer"`
+	// IndexerPsqlConn is the PostgreSQL connection string used by IndexerPsql.
+	IndexerPsqlConn string `mapstructure:"indexer_psql_conn"`
 
 	// CLI flags
 	DANamespace      string `mapstructure:"da_namespace"`
 	SequencerAddress string `mapstructure:"sequencer_address"`
+
+	// LegacyCatchingUp makes node status always report catching_up as false. Go IBC relayer
+	// requires this for its legacy encoding check, so it's enabled by default.
+	LegacyCatchingUp bool `mapstructure:"legacy_catching_up"`
 }
 
 // HeaderConfig allows node to pass the initial trusted header hash to start the header exchange service
@@ -72,6 +148,19 @@ type HeaderConfig struct {
 	TrustedHash string `mapstructure:"trusted_hash"`
 }
 
+// HealthConfig defines thresholds used by /healthz and /readyz endpoints.
+type HealthConfig struct {
+	// HealthMaxBlockAge is the maximum age of the latest produced or synced block. 0 disables the check.
+	HealthMaxBlockAge time.Duration `mapstructure:"health_max_block_age"`
+	// HealthMinPeers is the minimum number of connected P2P peers required for readiness.
+	HealthMinPeers uint64 `mapstructure:"health_min_peers"`
+	// HealthMaxIndexerLag is the maximum number of blocks not yet indexed allowed for readiness.
+	// 0 disables the check.
+	HealthMaxIndexerLag uint64 `mapstructure:"health_max_indexer_lag"`
+	// HealthCheckTimeout is the timeout of checks querying ABCI application, DA layer and sequencer.
+	HealthCheckTimeout time.Duration `mapstructure:"health_check_timeout"`
+}
+
 // BlockManagerConfig consists of all parameters required by BlockManagerConfig
 type BlockManagerConfig struct {
 	// BlockTime defines how often new blocks are produced
@@ -90,6 +179,39 @@ type BlockManagerConfig struct {
 	// LazyBlockTime defines how often new blocks are produced in lazy mode
 	// even if there are no transactions
 	LazyBlockTime time.Duration `mapstructure:"lazy_block_time"`
+	// IntermediateStateRoots enables generation (aggregator) and verification (full node)
+	// of intermediate state roots, required for fraud proofs.
+	IntermediateStateRoots bool `mapstructure:"intermediate_state_roots"`
+	// RequireCommittee defines whether headers have to carry a commit of the attester committee
+	// (validators in genesis). It's implied when genesis has more than one validator.
+	RequireCommittee bool `mapstructure:"require_committee"`
+	// EquivocationPolicy defines the reaction to conflicting headers signed by the sequencer,
+	// either EquivocationPolicyHalt or EquivocationPolicyAlert.
+	EquivocationPolicy string `mapstructure:"equivocation_policy"`
+	// DAConflictPolicy defines the reaction to blocks applied from P2P network that conflict
+	// with blocks published on DA, either DAConflictPolicyHalt or DAConflictPolicyRollback.
+	DAConflictPolicy string `mapstructure:"da_conflict_policy"`
+	// CatchingUpThreshold is the number of blocks the node can be behind the highest known
+	// height (from P2P network or DA) before it's considered to be catching up.
+	CatchingUpThreshold uint64 `mapstructure:"catching_up_threshold"`
+	// LeaseFile is the path of the file locked by the aggregator while producing blocks.
+	// Aggregators sharing the sequencer key and the lease file produce blocks one at a time.
+	// File locks work only on the same host; other hosts need a lease set with FullNode.SetLease.
+	LeaseFile string `mapstructure:"lease_file"`
+	// Standby defines whether the aggregator runs as a standby for the active aggregator. A standby
+	// aggregator syncs like a full node, and starts producing blocks when the active one is down.
+	// It requires a lease (LeaseFile or FullNode.SetLease), so it doesn't produce blocks while the
+	// active aggregator is only unreachable.
+	Standby bool `mapstructure:"standby"`
+	// StandbyMissedBlocks is the number of block times without new headers after which the active
+	// aggregator is considered down, and the standby aggregator is promoted.
+	StandbyMissedBlocks uint64 `mapstructure:"standby_missed_blocks"`
+	// HaltHeight is the height of the last block produced or synced before the node halts, e.g. for
+	// an upgrade. 0 means no halt height.
+	HaltHeight uint64 `mapstructure:"halt_height"`
+	// HaltTime is the time (in Unix seconds) at which the node halts, after the first block with
+	// time equal or greater. 0 means no halt time.
+	HaltTime uint64 `mapstructure:"halt_time"`
 }
 
 // GetNodeConfig translates Tendermint's configuration into Rollkit configuration.
@@ -116,6 +238,10 @@ func GetNodeConfig(nodeConf *NodeConfig, cmConf *cmcfg.Config) {
 		if cmConf.Instrumentation != nil {
 			nodeConf.Instrumentation = cmConf.Instrumentation
 		}
+		if cmConf.TxIndex != nil {
+			nodeConf.Indexer = cmConf.TxIndex.Indexer
+			nodeConf.IndexerPsqlConn = cmConf.TxIndex.PsqlConn
+		}
 	}
 }
 
@@ -139,6 +265,30 @@ func (nc *NodeConfig) GetViperConfig(v *viper.Viper) error {
 	nc.DAMempoolTTL = v.GetUint64(FlagDAMempoolTTL)
 	nc.LazyBlockTime = v.GetDuration(FlagLazyBlockTime)
 	nc.SequencerAddress = v.GetString(FlagSequencerAddress)
+	nc.IntermediateStateRoots = v.GetBool(FlagIntermediateStateRoots)
+	nc.RequireCommittee = v.GetBool(FlagRequireCommittee)
+	nc.EquivocationPolicy = v.GetString(FlagEquivocationPolicy)
+	nc.DAConflictPolicy = v.GetString(FlagDAConflictPolicy)
+	nc.CatchingUpThreshold = v.GetUint64(FlagCatchingUpThreshold)
+	nc.LeaseFile = v.GetString(FlagLeaseFile)
+	nc.Standby = v.GetBool(FlagStandby)
+	nc.StandbyMissedBlocks = v.GetUint64(FlagStandbyMissedBlocks)
+	nc.HaltHeight = v.GetUint64(FlagHaltHeight)
+	nc.HaltTime = v.GetUint64(FlagHaltTime)
+	nc.LegacyCatchingUp = v.GetBool(FlagLegacyCatchingUp)
+	nc.HealthMaxBlockAge = v.GetDuration(FlagHealthMaxBlockAge)
+	nc.HealthMinPeers = v.GetUint64(FlagHealthMinPeers)
+	nc.HealthMaxIndexerLag = v.GetUint64(FlagHealthMaxIndexerLag)
+	nc.HealthCheckTimeout = v.GetDuration(FlagHealthCheckTimeout)
+	nc.RPC.AuthTokens = v.GetStringSlice(FlagRPCAuthTokens)
+	nc.RPC.JWTSecret = v.GetString(FlagRPCJWTSecret)
+	nc.RPC.ProtectedMethods = v.GetStringSlice(FlagRPCProtectedMethods)
+	nc.RPC.AllowedMethods = v.GetStringSlice(FlagRPCAllowedMethods)
+	nc.RPC.DeniedMethods = v.GetStringSlice(FlagRPCDeniedMethods)
+	nc.RPC.RateLimit = v.GetFloat64(FlagRPCRateLimit)
+	nc.RPC.RateLimitBurst = v.GetInt(FlagRPCRateLimitBurst)
+	nc.RPC.TokenRateLimit = v.GetFloat64(FlagRPCTokenRateLimit)
+	nc.RPC.TokenRateLimitBurst = v.GetInt(FlagRPCTokenRateLimitBurst)
 
 	return nil
 }
@@ -165,4 +315,28 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().Uint64(FlagDAMempoolTTL, def.DAMempoolTTL, "number of DA blocks until transaction is dropped from the mempool")
 	cmd.Flags().Duration(FlagLazyBlockTime, def.LazyBlockTime, "block time (for lazy mode)")
 	cmd.Flags().String(FlagSequencerAddress, def.SequencerAddress, "sequencer middleware address (host:port)")
+	cmd.Flags().Bool(FlagIntermediateStateRoots, def.IntermediateStateRoots, "generate and verify intermediate state roots (for fraud proofs)")
+	cmd.Flags().Bool(FlagRequireCommittee, def.RequireCommittee, "require headers attested by the committee of genesis validators")
+	cmd.Flags().String(FlagEquivocationPolicy, def.EquivocationPolicy, "reaction to sequencer equivocation (halt|alert)")
+	cmd.Flags().String(FlagDAConflictPolicy, def.DAConflictPolicy, "reaction to P2P blocks contradicted by DA (halt|rollback)")
+	cmd.Flags().String(FlagLeaseFile, def.LeaseFile, "file locked by the aggregator while producing blocks (for failover between aggregators on the same host)")
+	cmd.Flags().Bool(FlagStandby, def.Standby, "run aggregator as a standby, producing blocks when the active aggregator is down (for aggregator mode, requires a lease)")
+	cmd.Flags().Uint64(FlagStandbyMissedBlocks, def.StandbyMissedBlocks, "number of block times without new headers after which standby aggregator is promoted")
+	cmd.Flags().Uint64(FlagHaltHeight, def.HaltHeight, "height of the last block before the node halts, e.g. for an upgrade (0 to disable)")
+	cmd.Flags().Uint64(FlagHaltTime, def.HaltTime, "block time (in Unix seconds) at which the node halts, e.g. for an upgrade (0 to disable)")
+	cmd.Flags().Uint64(FlagCatchingUpThreshold, def.CatchingUpThreshold, "number of blocks behind the highest known height at which node is catching up")
+	cmd.Flags().Bool(FlagLegacyCatchingUp, def.LegacyCatchingUp, "always report catching_up as false in status (for IBC relayers, set to false to report sync progress)")
+	cmd.Flags().Duration(FlagHealthMaxBlockAge, def.HealthMaxBlockAge, "maximum age of the latest block reported as healthy (0 to disable)")
+	cmd.Flags().Uint64(FlagHealthMinPeers, def.HealthMinPeers, "minimum number of P2P peers reported as ready")
+	cmd.Flags().Uint64(FlagHealthMaxIndexerLag, def.HealthMaxIndexerLag, "maximum number of blocks not yet indexed reported as ready (0 to disable)")
+	cmd.Flags().Duration(FlagHealthCheckTimeout, def.HealthCheckTimeout, "timeout of a single health check")
+	cmd.Flags().StringSlice(FlagRPCAuthTokens, def.RPC.AuthTokens, "bearer tokens allowing calls to protected RPC methods")
+	cmd.Flags().String(FlagRPCJWTSecret, def.RPC.JWTSecret, "secret used to verify HS256 JWT bearer tokens")
+	cmd.Flags().StringSlice(FlagRPCProtectedMethods, def.RPC.ProtectedMethods, "RPC method patterns requiring authentication (if tokens or JWT secret are set)")
+	cmd.Flags().StringSlice(FlagRPCAllowedMethods, def.RPC.AllowedMethods, "RPC method patterns allowed to be called (empty to allow all)")
+	cmd.Flags().StringSlice(FlagRPCDeniedMethods, def.RPC.DeniedMethods, "RPC method patterns not allowed to be called")
+	cmd.Flags().Float64(FlagRPCRateLimit, def.RPC.RateLimit, "RPC calls per second allowed from a single IP address (0 for no limit)")
+	cmd.Flags().Int(FlagRPCRateLimitBurst, def.RPC.RateLimitBurst, "RPC calls from a single IP address allowed at once")
+	cmd.Flags().Float64(FlagRPCTokenRateLimit, def.RPC.TokenRateLimit, "RPC calls per second allowed with a single bearer token (0 for no limit)")
+	cmd.Flags().Int(FlagRPCTokenRateLimitBurst, def.RPC.TokenRateLimitBurst, "RPC calls with a single bearer token allowed at once")
 }
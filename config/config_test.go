@@ -24,6 +24,7 @@ func TestGetNodeConfig(t *testing.T) {
 		{"ListenAddress", &cmcfg.Config{P2P: &cmcfg.P2PConfig{ListenAddress: "127.0.0.1:7676"}}, NodeConfig{P2P: P2PConfig{ListenAddress: "127.0.0.1:7676"}}},
 		{"RootDir", &cmcfg.Config{BaseConfig: cmcfg.BaseConfig{RootDir: "~/root"}}, NodeConfig{RootDir: "~/root"}},
 		{"DBPath", &cmcfg.Config{BaseConfig: cmcfg.BaseConfig{DBPath: "./database"}}, NodeConfig{DBPath: "./database"}},
+		{"TxIndex", &cmcfg.Config{TxIndex: &cmcfg.TxIndexConfig{Indexer: "psql", PsqlConn: "postgres://localhost"}}, NodeConfig{Indexer: IndexerPsql, IndexerPsqlConn: "postgres://localhost"}},
 	}
 
 	for _, c := range cases {
@@ -50,6 +51,7 @@ func TestViperAndCobra(t *testing.T) {
 	assert.NoError(cmd.Flags().Set(FlagDAAddress, `{"json":true}`))
 	assert.NoError(cmd.Flags().Set(FlagBlockTime, "1234s"))
 	assert.NoError(cmd.Flags().Set(FlagDANamespace, "0102030405060708"))
+	assert.NoError(cmd.Flags().Set(FlagHealthMaxBlockAge, "30s"))
 
 	nc := DefaultNodeConfig
 
@@ -58,4 +60,6 @@ func TestViperAndCobra(t *testing.T) {
 	assert.Equal(true, nc.Aggregator)
 	assert.Equal(`{"json":true}`, nc.DAAddress)
 	assert.Equal(1234*time.Second, nc.BlockTime)
+	assert.Equal(30*time.Second, nc.HealthMaxBlockAge)
+	assert.Equal(5*time.Second, nc.HealthCheckTimeout)
 }
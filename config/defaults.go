@@ -20,12 +20,21 @@ var DefaultNodeConfig = NodeConfig{
 		ListenAddress: DefaultListenAddress,
 		Seeds:         "",
 	},
+	RPC: RPCConfig{
+		ProtectedMethods:    []string{"broadcast_tx_*", "broadcast_evidence", "unsafe_*"},
+		RateLimitBurst:      100,
+		TokenRateLimitBurst: 100,
+	},
 	Aggregator: false,
 	BlockManagerConfig: BlockManagerConfig{
-		BlockTime:      1 * time.Second,
-		DABlockTime:    15 * time.Second,
-		LazyAggregator: false,
-		LazyBlockTime:  60 * time.Second,
+		BlockTime:           1 * time.Second,
+		DABlockTime:         15 * time.Second,
+		LazyAggregator:      false,
+		LazyBlockTime:       60 * time.Second,
+		EquivocationPolicy:  EquivocationPolicyHalt,
+		DAConflictPolicy:    DAConflictPolicyHalt,
+		CatchingUpThreshold: 5,
+		StandbyMissedBlocks: 10,
 	},
 	DAAddress:       "http://localhost:26658",
 	DAGasPrice:      -1,
@@ -34,6 +43,10 @@ var DefaultNodeConfig = NodeConfig{
 	HeaderConfig: HeaderConfig{
 		TrustedHash: "",
 	},
+	HealthConfig: HealthConfig{
+		HealthCheckTimeout: 5 * time.Second,
+	},
 	Instrumentation:  config.DefaultInstrumentationConfig(),
 	SequencerAddress: "localhost:50051",
+	LegacyCatchingUp: true,
 }
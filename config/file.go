@@ -0,0 +1,200 @@
+package config
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	cmcfg "github.com/cometbft/cometbft/config"
+)
+
+// MaxNamespaceSize is the maximum size of a DA namespace in bytes (the size of Celestia namespaces).
+const MaxNamespaceSize = 29
+
+// ValidateNamespace checks that namespace is a hex encoded DA namespace of at most MaxNamespaceSize bytes.
+func ValidateNamespace(namespace string) error {
+	bz, err := hex.DecodeString(namespace)
+	if err != nil {
+		return fmt.Errorf("namespace must be hex encoded: %w", err)
+	}
+	if len(bz) > MaxNamespaceSize {
+		return fmt.Errorf("namespace must be at most %d bytes, got %d bytes", MaxNamespaceSize, len(bz))
+	}
+	return nil
+}
+
+// WriteConfigFile writes CometBFT configuration cmConf, followed by a rollkit section holding
+// nodeConf, to the config file at path. Keys of the rollkit section match Rollkit flags, so the
+// file can be read with viper and GetViperConfig.
+func WriteConfigFile(path string, cmConf *cmcfg.Config, nodeConf NodeConfig) error {
+	var buf bytes.Buffer
+	if err := rollkitConfigTemplate.Execute(&buf, nodeConf); err != nil {
+		return err
+	}
+	cmcfg.WriteConfigFile(path, cmConf)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+var rollkitConfigTemplate = template.Must(template.New("rollkit").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+	"quoteAll": func(values []string) string {
+		quoted := make([]string, len(values))
+		for i, value := range values {
+			quoted[i] = strconv.Quote(value)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
+	},
+}).Parse(`
+#######################################################
+###          Rollkit Configuration Options          ###
+#######################################################
+[rollkit]
+
+# Run node in aggregator mode, producing blocks
+aggregator = {{ .Aggregator }}
+
+# Run light client
+light = {{ .Light }}
+
+# Initial trusted hash to start the header exchange service
+trusted_hash = {{ quote .TrustedHash }}
+
+# Address of the sequencer middleware (host:port)
+sequencer_address = {{ quote .SequencerAddress }}
+
+#######################################################
+###               Block Production                  ###
+#######################################################
+
+# Block time (for aggregator mode)
+block_time = "{{ .BlockTime }}"
+
+# Wait for transactions, don't build empty blocks
+lazy_aggregator = {{ .LazyAggregator }}
+
+# Block time in lazy mode
+lazy_block_time = "{{ .LazyBlockTime }}"
+
+# Limit of blocks pending DA submission (0 for no limit)
+max_pending_blocks = {{ .MaxPendingBlocks }}
+
+# Generate and verify intermediate state roots (for fraud proofs)
+intermediate_state_roots = {{ .IntermediateStateRoots }}
+
+# Require headers attested by the committee of genesis validators
+require_committee = {{ .RequireCommittee }}
+
+# Reaction to sequencer equivocation (halt|alert)
+equivocation_policy = {{ quote .EquivocationPolicy }}
+
+# Reaction to P2P blocks contradicted by DA (halt|rollback)
+da_conflict_policy = {{ quote .DAConflictPolicy }}
+
+# File locked by the aggregator while producing blocks (for failover between aggregators on the same host)
+lease_file = {{ quote .LeaseFile }}
+
+# Run aggregator as a standby, producing blocks when the active aggregator is down (for aggregator mode, requires a lease)
+standby = {{ .Standby }}
+
+# Number of block times without new headers after which standby aggregator is promoted
+standby_missed_blocks = {{ .StandbyMissedBlocks }}
+
+# Height of the last block before the node halts, e.g. for an upgrade (0 to disable)
+halt_height = {{ .HaltHeight }}
+
+# Block time (in Unix seconds) at which the node halts, e.g. for an upgrade (0 to disable)
+halt_time = {{ .HaltTime }}
+
+# Number of blocks behind the highest known height at which node is catching up
+catching_up_threshold = {{ .CatchingUpThreshold }}
+
+# Always report catching_up as false in status (for IBC relayers)
+legacy_catching_up = {{ .LegacyCatchingUp }}
+
+#######################################################
+###              Data Availability                  ###
+#######################################################
+
+# DA address
+da_address = {{ quote .DAAddress }}
+
+# DA auth token
+da_auth_token = {{ quote .DAAuthToken }}
+
+# Hex encoded DA namespace to submit blob transactions
+da_namespace = {{ quote .DANamespace }}
+
+# DA chain block time (for syncing)
+da_block_time = "{{ .DABlockTime }}"
+
+# Starting DA block height (for syncing)
+da_start_height = {{ .DAStartHeight }}
+
+# DA gas price for blob transactions (-1 for automatic)
+da_gas_price = {{ .DAGasPrice }}
+
+# DA gas price multiplier for retrying blob transactions
+da_gas_multiplier = {{ .DAGasMultiplier }}
+
+# Number of DA blocks until transaction is dropped from the mempool
+da_mempool_ttl = {{ .DAMempoolTTL }}
+
+#######################################################
+###                Health Checks                    ###
+#######################################################
+
+# Maximum age of the latest block reported as healthy (0 to disable)
+health_max_block_age = "{{ .HealthMaxBlockAge }}"
+
+# Minimum number of P2P peers reported as ready
+health_min_peers = {{ .HealthMinPeers }}
+
+# Maximum number of blocks not yet indexed reported as ready (0 to disable)
+health_max_indexer_lag = {{ .HealthMaxIndexerLag }}
+
+# Timeout of a single health check
+health_check_timeout = "{{ .HealthCheckTimeout }}"
+
+#######################################################
+###                 RPC Access                      ###
+#######################################################
+
+# Bearer tokens allowing calls to protected RPC methods
+rpc_auth_tokens = {{ quoteAll .RPC.AuthTokens }}
+
+# Secret used to verify HS256 JWT bearer tokens (tokens must have the exp claim)
+rpc_jwt_secret = {{ quote .RPC.JWTSecret }}
+
+# RPC method patterns requiring authentication (if tokens or JWT secret are set)
+rpc_protected_methods = {{ quoteAll .RPC.ProtectedMethods }}
+
+# RPC method patterns allowed to be called (empty to allow all)
+rpc_allowed_methods = {{ quoteAll .RPC.AllowedMethods }}
+
+# RPC method patterns not allowed to be called
+rpc_denied_methods = {{ quoteAll .RPC.DeniedMethods }}
+
+# RPC calls per second allowed from a single IP address (0 for no limit)
+rpc_rate_limit = {{ .RPC.RateLimit }}
+
+# RPC calls from a single IP address allowed at once
+rpc_rate_limit_burst = {{ .RPC.RateLimitBurst }}
+
+# RPC calls per second allowed with a single bearer token (0 for no limit)
+rpc_token_rate_limit = {{ .RPC.TokenRateLimit }}
+
+# RPC calls with a single bearer token allowed at once
+rpc_token_rate_limit_burst = {{ .RPC.TokenRateLimitBurst }}
+`))
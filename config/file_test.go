@@ -0,0 +1,61 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNamespace(t *testing.T) {
+	assert := assert.New(t)
+	assert.NoError(ValidateNamespace(""))
+	assert.NoError(ValidateNamespace("0102030405060708"))
+	assert.NoError(ValidateNamespace("00000000000000000000000000000000000000000000000000deadbeef"))
+	assert.ErrorContains(ValidateNamespace("namespace"), "hex encoded")
+	assert.ErrorContains(ValidateNamespace("012"), "hex encoded")
+	assert.ErrorContains(ValidateNamespace("00000000000000000000000000000000000000000000000000deadbeef00"), "at most 29 bytes")
+}
+
+func TestWriteConfigFile(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "config.toml")
+
+	cmConf := cmcfg.DefaultConfig()
+	cmConf.Moniker = "sequencer"
+	cmConf.P2P.Seeds = "seed@127.0.0.1:26656"
+	nodeConf := DefaultNodeConfig
+	nodeConf.Aggregator = true
+	nodeConf.DAAddress = "http://127.0.0.1:26658"
+	nodeConf.DANamespace = "0102030405060708"
+	nodeConf.BlockTime = 2 * time.Second
+	nodeConf.DAGasPrice = 0.5
+	nodeConf.RPC.DeniedMethods = []string{"unsafe_*", `quoted"method`}
+	require.NoError(WriteConfigFile(path, cmConf, nodeConf))
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(v.ReadInConfig())
+	assert.Equal("sequencer", v.GetString("moniker"))
+	assert.Equal("seed@127.0.0.1:26656", v.GetString("p2p.seeds"))
+
+	var read NodeConfig
+	require.NoError(read.GetViperConfig(v))
+	assert.True(read.Aggregator)
+	assert.Equal(nodeConf.DAAddress, read.DAAddress)
+	assert.Equal(nodeConf.DANamespace, read.DANamespace)
+	assert.Equal(nodeConf.BlockTime, read.BlockTime)
+	assert.Equal(nodeConf.DABlockTime, read.DABlockTime)
+	assert.Equal(nodeConf.DAGasPrice, read.DAGasPrice)
+	assert.Equal(nodeConf.SequencerAddress, read.SequencerAddress)
+	assert.Equal(nodeConf.EquivocationPolicy, read.EquivocationPolicy)
+	assert.Equal(nodeConf.HealthCheckTimeout, read.HealthCheckTimeout)
+	assert.Equal(nodeConf.RPC.ProtectedMethods, read.RPC.ProtectedMethods)
+	assert.Equal(nodeConf.RPC.DeniedMethods, read.RPC.DeniedMethods)
+	assert.Empty(read.RPC.AuthTokens)
+}
@@ -35,4 +35,25 @@ type RPCConfig struct {
 	// NOTE: both tls-cert-file and tls-key-file must be present for Tendermint to create HTTPS server.
 	// Otherwise, HTTP server is run.
 	TLSKeyFile string `mapstructure:"tls-key-file"`
+
+	// AuthTokens is a list of bearer tokens allowing calls to protected methods.
+	AuthTokens []string `mapstructure:"rpc_auth_tokens"`
+	// JWTSecret is a secret used to verify HS256 signed JWT bearer tokens allowing calls to protected methods.
+	JWTSecret string `mapstructure:"rpc_jwt_secret"`
+	// ProtectedMethods is a list of method patterns (see path.Match) requiring authentication.
+	// Protected methods are not restricted if neither AuthTokens nor JWTSecret is set.
+	ProtectedMethods []string `mapstructure:"rpc_protected_methods"`
+	// AllowedMethods is a list of method patterns allowed to be called. Empty list allows all methods.
+	AllowedMethods []string `mapstructure:"rpc_allowed_methods"`
+	// DeniedMethods is a list of method patterns not allowed to be called. It takes precedence over AllowedMethods.
+	DeniedMethods []string `mapstructure:"rpc_denied_methods"`
+	// RateLimit is the number of calls per second allowed from a single IP address. 0 - unlimited.
+	RateLimit float64 `mapstructure:"rpc_rate_limit"`
+	// RateLimitBurst is the maximum number of calls from a single IP address allowed at once.
+	RateLimitBurst int `mapstructure:"rpc_rate_limit_burst"`
+	// TokenRateLimit is the number of calls per second allowed with a single bearer token. 0 - unlimited.
+	// Authenticated calls are not subject to RateLimit.
+	TokenRateLimit float64 `mapstructure:"rpc_token_rate_limit"`
+	// TokenRateLimitBurst is the maximum number of calls with a single bearer token allowed at once.
+	TokenRateLimitBurst int `mapstructure:"rpc_token_rate_limit_burst"`
 }
@@ -68,6 +68,28 @@ const (
 	StatusError
 )
 
+// String returns human readable name of the status code.
+func (c StatusCode) String() string {
+	switch c {
+	case StatusSuccess:
+		return "success"
+	case StatusNotFound:
+		return "not_found"
+	case StatusNotIncludedInBlock:
+		return "not_included_in_block"
+	case StatusAlreadyInMempool:
+		return "already_in_mempool"
+	case StatusTooBig:
+		return "too_big"
+	case StatusContextDeadline:
+		return "context_deadline"
+	case StatusError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
 // BaseResult contains basic information returned by DA layer.
 type BaseResult struct {
 	// Code is to determine if the action succeeded.
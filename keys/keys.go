@@ -0,0 +1,185 @@
+// Package keys provides generation, derivation and encrypted storage of sequencer signing keys and
+// P2P node keys.
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	cmed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/go-bip39"
+	libp2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// KeyTypeEd25519 is the type of ed25519 keys.
+	KeyTypeEd25519 = cmed25519.KeyType
+	// KeyTypeSecp256k1 is the type of secp256k1 keys.
+	KeyTypeSecp256k1 = cmsecp256k1.KeyType
+
+	// CoinType is the BIP-44 coin type used to derive keys from mnemonics, the one of Cosmos.
+	CoinType = 118
+
+	// mnemonicEntropySize is the entropy of generated mnemonics in bits, giving 24 words.
+	mnemonicEntropySize = 256
+
+	hardened = 1 << 31
+)
+
+var (
+	// ErrUnsupportedKeyType is returned for key types other than KeyTypeEd25519 and KeyTypeSecp256k1.
+	ErrUnsupportedKeyType = errors.New("unsupported key type")
+
+	// ErrInvalidMnemonic is returned when a mnemonic is not a valid BIP-39 mnemonic.
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+)
+
+// GenerateKey generates a random private key of given type.
+func GenerateKey(keyType string) (cmcrypto.PrivKey, error) {
+	switch keyType {
+	case KeyTypeEd25519:
+		return cmed25519.GenPrivKey(), nil
+	case KeyTypeSecp256k1:
+		return cmsecp256k1.GenPrivKey(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, keyType)
+	}
+}
+
+// NewMnemonic returns a random 24 words BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// FromMnemonic derives a private key of given type from a BIP-39 mnemonic.
+//
+// Secp256k1 keys are derived with BIP-32 on the path m/44'/118'/account'/0/index, the same as
+// Cosmos SDK accounts. Ed25519 keys are derived with SLIP-10 on the path
+// m/44'/118'/account'/0'/index', as SLIP-10 supports only hardened derivation for ed25519.
+func FromMnemonic(mnemonic, keyType string, account, index uint32) (cmcrypto.PrivKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMnemonic, err)
+	}
+	switch keyType {
+	case KeyTypeEd25519:
+		key, err := deriveEd25519(seed, []uint32{44 | hardened, CoinType | hardened, account | hardened, 0 | hardened, index | hardened})
+		if err != nil {
+			return nil, err
+		}
+		return cmed25519.PrivKey(ed25519.NewKeyFromSeed(key)), nil
+	case KeyTypeSecp256k1:
+		key, err := deriveSecp256k1(seed, []uint32{44 | hardened, CoinType | hardened, account | hardened, 0, index})
+		if err != nil {
+			return nil, err
+		}
+		return cmsecp256k1.PrivKey(key), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, keyType)
+	}
+}
+
+// deriveSecp256k1 derives a secp256k1 private key from seed along path, as specified by BIP-32.
+func deriveSecp256k1(seed []byte, path []uint32) ([]byte, error) {
+	key, chainCode := hmacSHA512([]byte("Bitcoin seed"), seed)
+	n := btcec.S256().N
+	for _, i := range path {
+		data := make([]byte, 0, 37)
+		if i >= hardened {
+			data = append(append(data, 0), key...)
+		} else {
+			_, pub := btcec.PrivKeyFromBytes(key)
+			data = append(data, pub.SerializeCompressed()...)
+		}
+		data = binary.BigEndian.AppendUint32(data, i)
+		il, ir := hmacSHA512(chainCode, data)
+
+		child := new(big.Int).SetBytes(il)
+		if child.Cmp(n) >= 0 {
+			return nil, errors.New("invalid derived key")
+		}
+		child.Add(child, new(big.Int).SetBytes(key)).Mod(child, n)
+		if child.Sign() == 0 {
+			return nil, errors.New("invalid derived key")
+		}
+		key = child.FillBytes(make([]byte, 32))
+		chainCode = ir
+	}
+	return key, nil
+}
+
+// deriveEd25519 derives an ed25519 private key seed from seed along path, as specified by SLIP-10.
+func deriveEd25519(seed []byte, path []uint32) ([]byte, error) {
+	key, chainCode := hmacSHA512([]byte("ed25519 seed"), seed)
+	for _, i := range path {
+		if i < hardened {
+			return nil, errors.New("ed25519 supports only hardened derivation")
+		}
+		data := make([]byte, 0, 37)
+		data = append(append(data, 0), key...)
+		data = binary.BigEndian.AppendUint32(data, i)
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+	return key, nil
+}
+
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+// Info describes a public key.
+type Info struct {
+	Type string `json:"type"`
+	// Address is the address of the key, used as the proposer address of blocks signed by the sequencer.
+	Address string `json:"address"`
+	// PubKey is the public key.
+	PubKey cmcrypto.PubKey `json:"pub_key"`
+	// PeerID is the libp2p peer ID of the node using the key as its P2P key.
+	PeerID string `json:"peer_id"`
+}
+
+// Describe returns information about pubKey.
+func Describe(pubKey cmcrypto.PubKey) (Info, error) {
+	var (
+		p2pKey libp2pcrypto.PubKey
+		err    error
+	)
+	switch pubKey.Type() {
+	case KeyTypeEd25519:
+		p2pKey, err = libp2pcrypto.UnmarshalEd25519PublicKey(pubKey.Bytes())
+	case KeyTypeSecp256k1:
+		p2pKey, err = libp2pcrypto.UnmarshalSecp256k1PublicKey(pubKey.Bytes())
+	default:
+		return Info{}, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, pubKey.Type())
+	}
+	if err != nil {
+		return Info{}, err
+	}
+	id, err := peer.IDFromPublicKey(p2pKey)
+	if err != nil {
+		return Info{}, err
+	}
+	return Info{
+		Type:    pubKey.Type(),
+		Address: pubKey.Address().String(),
+		PubKey:  pubKey,
+		PeerID:  id.String(),
+	}, nil
+}
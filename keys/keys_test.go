@@ -0,0 +1,134 @@
+package keys
+
+import (
+	"encoding/hex"
+	"testing"
+
+	cmed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestDeriveSecp256k1(t *testing.T) {
+	// test vector 1 of BIP-32
+	seed := mustHex(t, "000102030405060708090a0b0c0d0e0f")
+	cases := []struct {
+		name string
+		path []uint32
+		key  string
+	}{
+		{"master", nil, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"m/0'", []uint32{hardened}, "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{"m/0'/1/2'/2/1000000000", []uint32{hardened, 1, 2 | hardened, 2, 1000000000}, "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, err := deriveSecp256k1(seed, c.path)
+			require.NoError(t, err)
+			assert.Equal(t, c.key, hex.EncodeToString(key))
+		})
+	}
+}
+
+func TestDeriveEd25519(t *testing.T) {
+	// test vector 1 for ed25519 of SLIP-10
+	seed := mustHex(t, "000102030405060708090a0b0c0d0e0f")
+	cases := []struct {
+		name string
+		path []uint32
+		key  string
+	}{
+		{"master", nil, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"m/0'", []uint32{hardened}, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"m/0'/1'/2'/2'/1000000000'", []uint32{hardened, 1 | hardened, 2 | hardened, 2 | hardened, 1000000000 | hardened}, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, err := deriveEd25519(seed, c.path)
+			require.NoError(t, err)
+			assert.Equal(t, c.key, hex.EncodeToString(key))
+		})
+	}
+
+	_, err := deriveEd25519(seed, []uint32{1})
+	assert.Error(t, err)
+}
+
+func TestFromMnemonic(t *testing.T) {
+	for _, keyType := range []string{KeyTypeEd25519, KeyTypeSecp256k1} {
+		t.Run(keyType, func(t *testing.T) {
+			key1, err := FromMnemonic(testMnemonic, keyType, 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, keyType, key1.Type())
+
+			// whitespace in mnemonic is normalized
+			key2, err := FromMnemonic("  "+testMnemonic+"\n", keyType, 0, 0)
+			require.NoError(t, err)
+			assert.Equal(t, key1.Bytes(), key2.Bytes())
+
+			other, err := FromMnemonic(testMnemonic, keyType, 0, 1)
+			require.NoError(t, err)
+			assert.NotEqual(t, key1.Bytes(), other.Bytes())
+			other, err = FromMnemonic(testMnemonic, keyType, 1, 0)
+			require.NoError(t, err)
+			assert.NotEqual(t, key1.Bytes(), other.Bytes())
+		})
+	}
+
+	_, err := FromMnemonic("abandon abandon", KeyTypeEd25519, 0, 0)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+	_, err = FromMnemonic(testMnemonic, "sr25519", 0, 0)
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	_, err = FromMnemonic(mnemonic, KeyTypeEd25519, 0, 0)
+	assert.NoError(t, err)
+}
+
+func TestGenerateKey(t *testing.T) {
+	key, err := GenerateKey(KeyTypeEd25519)
+	require.NoError(t, err)
+	assert.IsType(t, cmed25519.PrivKey{}, key)
+	key, err = GenerateKey(KeyTypeSecp256k1)
+	require.NoError(t, err)
+	assert.IsType(t, cmsecp256k1.PrivKey{}, key)
+	_, err = GenerateKey("sr25519")
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+}
+
+func TestDescribe(t *testing.T) {
+	for _, keyType := range []string{KeyTypeEd25519, KeyTypeSecp256k1} {
+		t.Run(keyType, func(t *testing.T) {
+			privKey, err := GenerateKey(keyType)
+			require.NoError(t, err)
+			info, err := Describe(privKey.PubKey())
+			require.NoError(t, err)
+			assert.Equal(t, keyType, info.Type)
+			assert.Equal(t, privKey.PubKey().Address().String(), info.Address)
+
+			// peer ID matches the one used by the node
+			p2pKey, err := types.GetNodeKey(&p2p.NodeKey{PrivKey: privKey})
+			require.NoError(t, err)
+			id, err := peer.IDFromPrivateKey(p2pKey)
+			require.NoError(t, err)
+			assert.Equal(t, id.String(), info.PeerID)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/armor"
+	cmed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	armorBlockType = "ROLLKIT PRIVATE KEY"
+	armorKDFScrypt = "scrypt"
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	saltSize = 16
+)
+
+// ErrWrongPassphrase is returned when an encrypted key can't be decrypted with given passphrase.
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted key")
+
+// Encrypt encrypts privKey with a key derived from passphrase and returns it in ASCII armor.
+// The public key is stored in the clear, so it can be read without the passphrase.
+func Encrypt(privKey cmcrypto.PrivKey, passphrase string) (string, error) {
+	plain, err := cmtjson.Marshal(privKey)
+	if err != nil {
+		return "", err
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plain)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	headers := map[string]string{
+		"kdf":     armorKDFScrypt,
+		"salt":    hex.EncodeToString(salt),
+		"type":    privKey.Type(),
+		"pub_key": base64.StdEncoding.EncodeToString(privKey.PubKey().Bytes()),
+	}
+	return armor.EncodeArmor(armorBlockType, headers, aead.Seal(nonce, nonce, plain, nil)), nil
+}
+
+// Decrypt decrypts a private key encrypted by Encrypt.
+func Decrypt(armored string, passphrase string) (cmcrypto.PrivKey, error) {
+	headers, data, err := decodeArmor(armored)
+	if err != nil {
+		return nil, err
+	}
+	salt, err := hex.DecodeString(headers["salt"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	var privKey cmcrypto.PrivKey
+	if err := cmtjson.Unmarshal(plain, &privKey); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+func decodeArmor(armored string) (map[string]string, []byte, error) {
+	blockType, headers, data, err := armor.DecodeArmor(armored)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode armor: %w", err)
+	}
+	if blockType != armorBlockType {
+		return nil, nil, fmt.Errorf("unexpected armor block type %q", blockType)
+	}
+	if headers["kdf"] != armorKDFScrypt {
+		return nil, nil, fmt.Errorf("unsupported key derivation function %q", headers["kdf"])
+	}
+	return headers, data, nil
+}
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.NewX(key)
+}
+
+// IsEncrypted returns true if the key file content bz is encrypted.
+func IsEncrypted(bz []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bz), []byte("-----BEGIN "+armorBlockType))
+}
+
+// keyFile is the content of CometBFT private validator key and node key files.
+type keyFile struct {
+	PrivKey cmcrypto.PrivKey `json:"priv_key"`
+}
+
+// ReadPrivKey reads the private key from file, which is either a CometBFT private validator key or
+// node key file, or a key encrypted by Encrypt. For encrypted keys, passphrase is called to get
+// the passphrase.
+func ReadPrivKey(file string, passphrase func() (string, error)) (cmcrypto.PrivKey, error) {
+	bz, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	if IsEncrypted(bz) {
+		pass, err := passphrase()
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := Decrypt(string(bz), pass)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt key file %s: %w", file, err)
+		}
+		return privKey, nil
+	}
+	var key keyFile
+	if err := cmtjson.Unmarshal(bz, &key); err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", file, err)
+	}
+	if key.PrivKey == nil {
+		return nil, fmt.Errorf("key file %s has no private key", file)
+	}
+	return key.PrivKey, nil
+}
+
+// ReadPubKey reads the public key from a key file read by ReadPrivKey, without decrypting it.
+func ReadPubKey(file string) (cmcrypto.PubKey, error) {
+	bz, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	if !IsEncrypted(bz) {
+		privKey, err := ReadPrivKey(file, nil)
+		if err != nil {
+			return nil, err
+		}
+		return privKey.PubKey(), nil
+	}
+	headers, _, err := decodeArmor(string(bz))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file %s: %w", file, err)
+	}
+	bz, err = base64.StdEncoding.DecodeString(headers["pub_key"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key of key file %s: %w", file, err)
+	}
+	switch headers["type"] {
+	case KeyTypeEd25519:
+		return cmed25519.PubKey(bz), nil
+	case KeyTypeSecp256k1:
+		return cmsecp256k1.PubKey(bz), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, headers["type"])
+	}
+}
+
+// WriteEncrypted encrypts privKey with passphrase and writes it to file, readable only by the owner.
+func WriteEncrypted(file string, privKey cmcrypto.PrivKey, passphrase string) error {
+	armored, err := Encrypt(privKey, passphrase)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, []byte(armored), 0o600)
+}
@@ -0,0 +1,84 @@
+package keys
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	for _, keyType := range []string{KeyTypeEd25519, KeyTypeSecp256k1} {
+		t.Run(keyType, func(t *testing.T) {
+			privKey, err := GenerateKey(keyType)
+			require.NoError(t, err)
+
+			armored, err := Encrypt(privKey, "secret")
+			require.NoError(t, err)
+			assert.True(t, IsEncrypted([]byte(armored)))
+			assert.NotContains(t, armored, string(privKey.Bytes()))
+
+			decrypted, err := Decrypt(armored, "secret")
+			require.NoError(t, err)
+			assert.True(t, privKey.Equals(decrypted))
+
+			_, err = Decrypt(armored, "wrong")
+			assert.ErrorIs(t, err, ErrWrongPassphrase)
+		})
+	}
+
+	_, err := Decrypt("not armored", "secret")
+	assert.Error(t, err)
+}
+
+func TestReadPrivKey(t *testing.T) {
+	dir := t.TempDir()
+	privKey, err := GenerateKey(KeyTypeEd25519)
+	require.NoError(t, err)
+
+	pvKeyFile := filepath.Join(dir, "priv_validator_key.json")
+	privval.NewFilePV(privKey, pvKeyFile, filepath.Join(dir, "priv_validator_state.json")).Key.Save()
+	nodeKeyFile := filepath.Join(dir, "node_key.json")
+	require.NoError(t, (&p2p.NodeKey{PrivKey: privKey}).SaveAs(nodeKeyFile))
+	encryptedFile := filepath.Join(dir, "encrypted.json")
+	require.NoError(t, WriteEncrypted(encryptedFile, privKey, "secret"))
+
+	noPassphrase := func() (string, error) {
+		t.Fatal("passphrase requested for plain key file")
+		return "", nil
+	}
+	for _, file := range []string{pvKeyFile, nodeKeyFile} {
+		key, err := ReadPrivKey(file, noPassphrase)
+		require.NoError(t, err)
+		assert.True(t, privKey.Equals(key))
+		pubKey, err := ReadPubKey(file)
+		require.NoError(t, err)
+		assert.True(t, privKey.PubKey().Equals(pubKey))
+	}
+
+	key, err := ReadPrivKey(encryptedFile, func() (string, error) { return "secret", nil })
+	require.NoError(t, err)
+	assert.True(t, privKey.Equals(key))
+	_, err = ReadPrivKey(encryptedFile, func() (string, error) { return "wrong", nil })
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+	errNoTerminal := errors.New("no terminal")
+	_, err = ReadPrivKey(encryptedFile, func() (string, error) { return "", errNoTerminal })
+	assert.ErrorIs(t, err, errNoTerminal)
+
+	// public key is readable without the passphrase
+	pubKey, err := ReadPubKey(encryptedFile)
+	require.NoError(t, err)
+	assert.True(t, privKey.PubKey().Equals(pubKey))
+
+	info, err := os.Stat(encryptedFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = ReadPrivKey(filepath.Join(dir, "missing.json"), noPassphrase)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,66 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/types"
+)
+
+// ExportBlocks writes blocks from height from to height to (inclusive) of the node to w as a chain
+// archive. If to is 0, blocks are exported up to the last block. It must not be called while the
+// node is running.
+func ExportBlocks(ctx context.Context, nodeConfig config.NodeConfig, w io.Writer, from, to uint64) (err error) {
+	s, err := openStore(nodeConfig)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+	return block.ExportBlocks(ctx, s, w, from, to)
+}
+
+// ImportBlocks imports blocks from the chain archive read from r into the store of the node.
+// It must not be called while the node is running.
+//
+// If clientCreator is not nil, blocks are executed against the application and their results are
+// compared with the archived ones. Otherwise, archived results are trusted, and application state
+// has to be restored separately, to the height of the returned state.
+func ImportBlocks(ctx context.Context, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, r io.Reader, clientCreator proxy.ClientCreator, logger log.Logger) (_ types.State, err error) {
+	s, err := openStore(nodeConfig)
+	if err != nil {
+		return types.State{}, err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+
+	var exec *state.BlockExecutor
+	if clientCreator != nil {
+		proxyApp, err := initProxyApp(clientCreator, logger, proxy.NopMetrics())
+		if err != nil {
+			return types.State{}, err
+		}
+		defer func() {
+			if stopErr := proxyApp.Stop(); stopErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to stop proxy app connections: %w", stopErr))
+			}
+		}()
+		mpool := initMempool(proxyApp, mempool.NopMetrics())
+		mpoolReaper := initMempoolReaper(mpool, []byte(genesis.ChainID), nil, logger)
+		exec = state.NewBlockExecutor(nil, genesis.ChainID, mpool, mpoolReaper, proxyApp.Consensus(), nil, 0,
+			nodeConfig.IntermediateStateRoots, logger.With("module", "BlockExecutor"), state.NopMetrics())
+	}
+	return block.ImportBlocks(ctx, s, genesis, r, exec)
+}
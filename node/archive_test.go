@@ -0,0 +1,75 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestExportImportBlocks(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	srcConfig := config.NodeConfig{RootDir: t.TempDir(), DBPath: "data"}
+	dstConfig := config.NodeConfig{RootDir: t.TempDir(), DBPath: "data"}
+
+	// save a chain of 3 blocks with their responses in the store of the source node
+	genesis, privKey := types.GetGenesisWithPrivkey("")
+	baseKV, err := store.NewDefaultKVStore(srcConfig.RootDir, srcConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s := store.New(newPrefixKV(baseKV, mainPrefix))
+	exec := state.NewBlockExecutor(nil, genesis.ChainID, nil, nil, nil, nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+	st, err := types.NewFromGenesisDoc(genesis)
+	require.NoError(err)
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1, PrivKey: privKey})
+	header.LastResultsHash = merkle.HashFromByteSlices(nil)
+	signature, err := types.GetSignature(header.Header, privKey)
+	require.NoError(err)
+	header.Signature = *signature
+	for i := 1; i <= 3; i++ {
+		resp := &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK}},
+			AppHash:   types.GetRandomBytes(32),
+		}
+		require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(s.SaveBlockResponses(ctx, header.Height(), resp))
+		st, err = exec.ApplyBlockResponses(st, header, data, resp)
+		require.NoError(err)
+		header, data = types.GetRandomNextBlock(header, data, privKey, resp.AppHash, 1)
+	}
+	require.NoError(s.UpdateState(ctx, st))
+	require.NoError(s.Close())
+
+	var archive bytes.Buffer
+	assert.Error(ExportBlocks(ctx, config.NodeConfig{}, &archive, 1, 0))
+	require.NoError(ExportBlocks(ctx, srcConfig, &archive, 1, 0))
+
+	imported, err := ImportBlocks(ctx, dstConfig, genesis, bytes.NewReader(archive.Bytes()), nil, log.NewNopLogger())
+	require.NoError(err)
+	assert.Equal(st.LastBlockHeight, imported.LastBlockHeight)
+	assert.Equal(st.LastBlockID, imported.LastBlockID)
+	assert.Equal(st.AppHash, imported.AppHash)
+
+	baseKV, err = store.NewDefaultKVStore(dstConfig.RootDir, dstConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s = store.New(newPrefixKV(baseKV, mainPrefix))
+	defer func() {
+		require.NoError(s.Close())
+	}()
+	stored, err := s.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(uint64(3), stored.LastBlockHeight)
+	_, _, err = s.GetBlockData(ctx, 3)
+	assert.NoError(err)
+}
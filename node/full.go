@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	ds "github.com/ipfs/go-datastore"
@@ -34,11 +35,15 @@ import (
 	"github.com/rollkit/rollkit/da"
 	"github.com/rollkit/rollkit/mempool"
 	"github.com/rollkit/rollkit/p2p"
+	"github.com/rollkit/rollkit/signer"
 	"github.com/rollkit/rollkit/state"
 	"github.com/rollkit/rollkit/state/indexer"
 	blockidxkv "github.com/rollkit/rollkit/state/indexer/block/kv"
+	blockidxnull "github.com/rollkit/rollkit/state/indexer/block/null"
+	"github.com/rollkit/rollkit/state/indexer/sink/psql"
 	"github.com/rollkit/rollkit/state/txindex"
 	"github.com/rollkit/rollkit/state/txindex/kv"
+	"github.com/rollkit/rollkit/state/txindex/null"
 	"github.com/rollkit/rollkit/store"
 	"github.com/rollkit/rollkit/types"
 )
@@ -101,7 +106,7 @@ func newFullNode(
 	ctx context.Context,
 	nodeConfig config.NodeConfig,
 	p2pKey crypto.PrivKey,
-	signingKey crypto.PrivKey,
+	signer signer.Signer,
 	clientCreator proxy.ClientCreator,
 	genesis *cmtypes.GenesisDoc,
 	metricsProvider MetricsProvider,
@@ -161,13 +166,13 @@ func newFullNode(
 	mempoolReaper := initMempoolReaper(mempool, []byte(genesis.ChainID), seqClient, logger.With("module", "reaper"))
 
 	store := store.New(mainKV)
-	blockManager, err := initBlockManager(signingKey, nodeConfig, genesis, store, mempool, mempoolReaper, seqClient, proxyApp, dalc, eventBus, logger, headerSyncService, dataSyncService, seqMetrics, smMetrics)
+	blockManager, err := initBlockManager(signer, nodeConfig, genesis, store, mempool, mempoolReaper, seqClient, proxyApp, dalc, eventBus, logger, headerSyncService, dataSyncService, seqMetrics, smMetrics)
 	if err != nil {
 		return nil, err
 	}
 
 	indexerKV := newPrefixKV(baseKV, indexerPrefix)
-	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(ctx, nodeConfig, indexerKV, eventBus, logger)
+	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(ctx, nodeConfig, genesis.ChainID, indexerKV, eventBus, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +202,14 @@ func newFullNode(
 
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 	node.p2pClient.SetTxValidator(node.newTxValidator(p2pMetrics))
+	node.p2pClient.SetEvidenceValidator(node.newEvidenceValidator())
+	node.p2pClient.SetFraudProofValidator(node.newFraudProofValidator())
+	if nodeConfig.IntermediateStateRoots {
+		blockManager.SetTxReplayer(state.NewABCITxReplayer(proxyApp.Query()))
+	}
+	if nodeConfig.DAConflictPolicy == config.DAConflictPolicyRollback {
+		blockManager.SetAppRollbacker(block.NewABCIAppRollbacker(proxyApp.Query()))
+	}
 	node.client = NewFullClient(node)
 
 	return node, nil
@@ -275,8 +288,8 @@ func initDataSyncService(mainKV ds.TxnDatastore, nodeConfig config.NodeConfig, g
 	return dataSyncService, nil
 }
 
-func initBlockManager(signingKey crypto.PrivKey, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, store store.Store, mempool mempool.Mempool, mempoolReaper *mempool.CListMempoolReaper, seqClient *seqGRPC.Client, proxyApp proxy.AppConns, dalc *da.DAClient, eventBus *cmtypes.EventBus, logger log.Logger, headerSyncService *block.HeaderSyncService, dataSyncService *block.DataSyncService, seqMetrics *block.Metrics, execMetrics *state.Metrics) (*block.Manager, error) {
-	blockManager, err := block.NewManager(signingKey, nodeConfig.BlockManagerConfig, genesis, store, mempool, mempoolReaper, seqClient, proxyApp.Consensus(), dalc, eventBus, logger.With("module", "BlockManager"), headerSyncService.Store(), dataSyncService.Store(), seqMetrics, execMetrics)
+func initBlockManager(signer signer.Signer, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, store store.Store, mempool mempool.Mempool, mempoolReaper *mempool.CListMempoolReaper, seqClient *seqGRPC.Client, proxyApp proxy.AppConns, dalc *da.DAClient, eventBus *cmtypes.EventBus, logger log.Logger, headerSyncService *block.HeaderSyncService, dataSyncService *block.DataSyncService, seqMetrics *block.Metrics, execMetrics *state.Metrics) (*block.Manager, error) {
+	blockManager, err := block.NewManager(signer, nodeConfig.BlockManagerConfig, genesis, store, mempool, mempoolReaper, seqClient, proxyApp.Consensus(), dalc, eventBus, logger.With("module", "BlockManager"), headerSyncService.Store(), dataSyncService.Store(), seqMetrics, execMetrics)
 	if err != nil {
 		return nil, fmt.Errorf("error while initializing BlockManager: %w", err)
 	}
@@ -344,11 +357,43 @@ func (n *FullNode) dataPublishLoop(ctx context.Context) {
 	}
 }
 
+func (n *FullNode) evidencePublishLoop(ctx context.Context) {
+	for {
+		select {
+		case ev := <-n.blockManager.EvidenceCh:
+			if err := n.p2pClient.GossipEvidence(ctx, ev.Bytes()); err != nil {
+				n.Logger.Error("failed to gossip evidence", "height", ev.Height(), "error", err)
+			}
+		case proof := <-n.blockManager.FraudProofCh:
+			bz, err := proof.MarshalBinary()
+			if err != nil {
+				n.Logger.Error("failed to marshal fraud proof", "height", proof.SignedHeader.Height(), "error", err)
+				continue
+			}
+			if err := n.p2pClient.GossipFraudProof(ctx, bz); err != nil {
+				n.Logger.Error("failed to gossip fraud proof", "height", proof.SignedHeader.Height(), "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // GetClient returns the RPC client for the full node.
 func (n *FullNode) GetClient() rpcclient.Client {
 	return n.client
 }
 
+// GetStore returns the block store of the full node.
+func (n *FullNode) GetStore() store.Store {
+	return n.Store
+}
+
+// GetBlockManager returns the block manager of the full node.
+func (n *FullNode) GetBlockManager() *block.Manager {
+	return n.blockManager
+}
+
 // Cancel calls the underlying context's cancel function.
 func (n *FullNode) Cancel() {
 	n.cancel()
@@ -378,6 +423,9 @@ func (n *FullNode) startPrometheusServer() *http.Server {
 
 // OnStart is a part of Service interface.
 func (n *FullNode) OnStart() error {
+	if err := n.blockManager.CheckSetup(); err != nil {
+		return err
+	}
 	// begin prometheus metrics gathering if it is enabled
 	if n.nodeConfig.Instrumentation != nil && n.nodeConfig.Instrumentation.IsPrometheusEnabled() {
 		n.prometheusSrv = n.startPrometheusServer()
@@ -403,6 +451,20 @@ func (n *FullNode) OnStart() error {
 		return err
 	}
 
+	// evidence and fraud proofs are published in every mode, so the ones queued when a standby
+	// aggregator stops syncing on promotion are not dropped
+	n.threadManager.Go(func() { n.evidencePublishLoop(n.ctx) })
+
+	if n.nodeConfig.Aggregator && n.nodeConfig.Standby {
+		n.Logger.Info("working in standby aggregator mode", "block time", n.nodeConfig.BlockTime)
+		// reaper and batch retrieval keep running, so that transactions are ready on promotion
+		if err = n.mempoolReaper.StartReaper(n.ctx); err != nil {
+			return fmt.Errorf("error while starting mempool reaper: %w", err)
+		}
+		n.threadManager.Go(func() { n.blockManager.BatchRetrieveLoop(n.ctx) })
+		n.threadManager.Go(func() { n.standbyLoop(n.ctx) })
+		return nil
+	}
 	if n.nodeConfig.Aggregator {
 		n.Logger.Info("working in aggregator mode", "block time", n.nodeConfig.BlockTime)
 		// reaper is started only in aggregator mode
@@ -423,6 +485,34 @@ func (n *FullNode) OnStart() error {
 	return nil
 }
 
+// standbyLoop syncs the node like a full node until the standby aggregator is promoted, then
+// stops syncing and starts producing blocks from the synced store.
+func (n *FullNode) standbyLoop(ctx context.Context) {
+	syncCtx, stopSync := context.WithCancel(ctx)
+	defer stopSync()
+	syncLoops := types.NewThreadManager()
+	syncLoops.Go(func() { n.blockManager.RetrieveLoop(syncCtx) })
+	syncLoops.Go(func() { n.blockManager.HeaderStoreRetrieveLoop(syncCtx) })
+	syncLoops.Go(func() { n.blockManager.DataStoreRetrieveLoop(syncCtx) })
+	syncLoops.Go(func() { n.blockManager.SyncLoop(syncCtx, n.cancel) })
+
+	if err := n.blockManager.WaitForPromotion(ctx); err != nil {
+		if ctx.Err() == nil && !errors.Is(err, block.ErrHalted) && !errors.Is(err, block.ErrAppFailed) {
+			n.Logger.Error("standby aggregator can't be promoted, working as full node", "error", err)
+		}
+		syncLoops.Wait()
+		return
+	}
+	stopSync()
+	syncLoops.Wait()
+	n.blockManager.Promote()
+	n.Logger.Info("standby aggregator promoted, working in aggregator mode")
+	n.threadManager.Go(func() { n.blockManager.AggregationLoop(ctx) })
+	n.threadManager.Go(func() { n.blockManager.HeaderSubmissionLoop(ctx) })
+	n.threadManager.Go(func() { n.headerPublishLoop(ctx) })
+	n.threadManager.Go(func() { n.dataPublishLoop(ctx) })
+}
+
 // GetGenesis returns entire genesis doc.
 func (n *FullNode) GetGenesis() *cmtypes.GenesisDoc {
 	return n.genesis
@@ -452,6 +542,10 @@ func (n *FullNode) OnStop() {
 		n.seqClient.Stop(),
 		n.IndexerService.Stop(),
 	)
+	// event sinks backed by external databases need to be closed
+	if closer, ok := n.TxIndexer.(io.Closer); ok {
+		err = errors.Join(err, closer.Close())
+	}
 	if n.prometheusSrv != nil {
 		err = errors.Join(err, n.prometheusSrv.Shutdown(n.ctx))
 	}
@@ -489,6 +583,47 @@ func (n *FullNode) AppClient() proxy.AppConns {
 	return n.proxyApp
 }
 
+// SetAppRollbacker sets the application rollbacker, required for rolling back blocks contradicted by DA
+// (see config.DAConflictPolicyRollback), replacing the default one using ABCI queries (see
+// block.RollbackQueryPath). It should be called before the node is started.
+func (n *FullNode) SetAppRollbacker(rollbacker block.AppRollbacker) {
+	n.blockManager.SetAppRollbacker(rollbacker)
+}
+
+// Halted returns a channel closed when the node halted at the halt height (see
+// config.BlockManagerConfig.HaltHeight) or on request of the application, or stopped because the
+// application failed to execute a block. Aggregators close it after the blocks produced before the
+// halt are submitted to DA.
+func (n *FullNode) Halted() <-chan struct{} {
+	return n.blockManager.Halted()
+}
+
+// HaltReason returns the reason of the halt, wrapping block.ErrHalted (or block.ErrAppFailed, if the
+// application failed to execute a block), or nil if the node didn't halt.
+func (n *FullNode) HaltReason() error {
+	return n.blockManager.HaltReason()
+}
+
+// SetTxReplayer sets the replayer used to verify fraud proofs received from the network, replacing
+// the default one using ABCI queries (see state.ReplayTxQueryPath). It should be called before the
+// node is started.
+func (n *FullNode) SetTxReplayer(replayer state.TxReplayer) {
+	n.blockManager.SetTxReplayer(replayer)
+}
+
+// SetLease sets the lease that has to be held by the aggregator to produce and submit blocks, for
+// failover between aggregators sharing the sequencer key. It should be called before the node is started.
+func (n *FullNode) SetLease(lease block.Lease) {
+	n.blockManager.SetLease(lease)
+}
+
+// SetAttester sets the attester collecting attestations of the committee members, required by the
+// aggregator when headers have to be attested by the committee (see config.BlockManagerConfig.RequireCommittee).
+// It should be called before the node is started.
+func (n *FullNode) SetAttester(attester block.Attester) {
+	n.blockManager.SetAttester(attester)
+}
+
 // newTxValidator creates a pubsub validator that uses the node's mempool to check the
 // transaction. If the transaction is valid, then it is added to the mempool
 func (n *FullNode) newTxValidator(metrics *p2p.Metrics) p2p.GossipValidator {
@@ -529,6 +664,38 @@ func (n *FullNode) newTxValidator(metrics *p2p.Metrics) p2p.GossipValidator {
 	}
 }
 
+// newEvidenceValidator returns a pubsub validator that accepts only valid equivocation evidence.
+func (n *FullNode) newEvidenceValidator() p2p.GossipValidator {
+	return func(m *p2p.GossipMessage) bool {
+		n.Logger.Debug("evidence received", "bytes", len(m.Data))
+		ev := new(types.EquivocationEvidence)
+		if err := ev.UnmarshalBinary(m.Data); err != nil {
+			return false
+		}
+		if err := n.blockManager.AddEquivocationEvidence(n.ctx, ev); err != nil {
+			n.Logger.Debug("rejected evidence", "error", err)
+			return false
+		}
+		return true
+	}
+}
+
+// newFraudProofValidator returns a pubsub validator that accepts only verified fraud proofs.
+func (n *FullNode) newFraudProofValidator() p2p.GossipValidator {
+	return func(m *p2p.GossipMessage) bool {
+		n.Logger.Debug("fraud proof received", "bytes", len(m.Data))
+		proof := new(types.FraudProof)
+		if err := proof.UnmarshalBinary(m.Data); err != nil {
+			return false
+		}
+		if err := n.blockManager.AddFraudProof(n.ctx, proof); err != nil {
+			n.Logger.Debug("rejected fraud proof", "error", err)
+			return false
+		}
+		return true
+	}
+}
+
 func newPrefixKV(kvStore ds.Datastore, prefix string) ds.TxnDatastore {
 	return (ktds.Wrap(kvStore, ktds.PrefixTransform{Prefix: ds.NewKey(prefix)}).Children()[0]).(ds.TxnDatastore)
 }
@@ -536,6 +703,7 @@ func newPrefixKV(kvStore ds.Datastore, prefix string) ds.TxnDatastore {
 func createAndStartIndexerService(
 	ctx context.Context,
 	conf config.NodeConfig,
+	chainID string,
 	kvStore ds.TxnDatastore,
 	eventBus *cmtypes.EventBus,
 	logger log.Logger,
@@ -545,8 +713,26 @@ func createAndStartIndexerService(
 		blockIndexer indexer.BlockIndexer
 	)
 
-	txIndexer = kv.NewTxIndex(ctx, kvStore)
-	blockIndexer = blockidxkv.New(ctx, newPrefixKV(kvStore, "block_events"))
+	switch conf.Indexer {
+	case "", config.IndexerKV:
+		txIndexer = kv.NewTxIndex(ctx, kvStore)
+		blockIndexer = blockidxkv.New(ctx, newPrefixKV(kvStore, "block_events"))
+	case config.IndexerPsql:
+		if conf.IndexerPsqlConn == "" {
+			return nil, nil, nil, errors.New("the psql connection settings cannot be empty")
+		}
+		es, err := psql.NewEventSink(conf.IndexerPsqlConn, chainID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create psql event sink: %w", err)
+		}
+		txIndexer = es.TxIndexer()
+		blockIndexer = es.BlockIndexer()
+	case config.IndexerNull:
+		txIndexer = &null.TxIndex{}
+		blockIndexer = &blockidxnull.BlockerIndexer{}
+	default:
+		return nil, nil, nil, fmt.Errorf("unsupported indexer %q", conf.Indexer)
+	}
 
 	indexerService := txindex.NewIndexerService(ctx, txIndexer, blockIndexer, eventBus, false)
 	indexerService.SetLogger(logger.With("module", "txindex"))
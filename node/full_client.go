@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -18,9 +17,11 @@ import (
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
+	ds "github.com/ipfs/go-datastore"
 
 	rconfig "github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/state/indexer"
 	"github.com/rollkit/rollkit/types"
 	abciconv "github.com/rollkit/rollkit/types/abci"
 )
@@ -509,8 +510,11 @@ func (c *FullClient) Commit(ctx context.Context, height *int64) (*ctypes.ResultC
 		return nil, errors.New("empty validator set found in block")
 	}
 
-	val := header.Validators.Validators[0].Address
-	commit := types.GetABCICommit(heightValue, header.Hash(), val, header.Time(), header.Signature)
+	commit := header.Commit
+	if commit == nil {
+		val := header.Validators.Validators[0].Address
+		commit = types.GetABCICommit(heightValue, header.Hash(), val, header.Time(), header.Signature)
+	}
 
 	block, err := abciconv.ToABCIBlock(header, data)
 	if err != nil {
@@ -564,12 +568,9 @@ func (c *FullClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.R
 
 	var proof cmtypes.TxProof
 	if prove {
-		_, data, _ := c.node.Store.GetBlockData(ctx, uint64(height))
-		blockProof := data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
-		proof = cmtypes.TxProof{
-			RootHash: blockProof.RootHash,
-			Data:     cmtypes.Tx(blockProof.Data),
-			Proof:    blockProof.Proof,
+		proof, err = newTxProofCache(c.node.Store).proof(ctx, uint64(height), index) //nolint:gosec
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -584,58 +585,74 @@ func (c *FullClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.R
 }
 
 // TxSearch returns detailed information about transactions matching query.
+// All matching transactions are found to count them, see TxSearchCursor for pages found by
+// scanning only the heights of the page.
 func (c *FullClient) TxSearch(ctx context.Context, query string, prove bool, pagePtr, perPagePtr *int, orderBy string) (*ctypes.ResultTxSearch, error) {
-	q, err := cmquery.New(query)
+	order, err := indexer.ParseOrder(orderBy)
 	if err != nil {
 		return nil, err
 	}
+	perPage := validatePerPage(perPagePtr)
+	page := 1
+	if pagePtr != nil {
+		page = *pagePtr
+	}
 
-	results, err := c.node.TxIndexer.Search(ctx, q)
+	txs, info, err := c.searchTxs(ctx, query, prove, indexer.SearchOptions{
+		Order:  order,
+		Offset: validateSkipCount(page, perPage),
+		Limit:  perPage,
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
-				return results[i].Index > results[j].Index
-			}
-			return results[i].Height > results[j].Height
-		})
-	case "asc", "":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
-				return results[i].Index < results[j].Index
-			}
-			return results[i].Height < results[j].Height
-		})
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	if _, err := validatePage(pagePtr, perPage, info.TotalCount); err != nil {
+		return nil, err
 	}
 
-	// paginate results
-	totalCount := len(results)
-	perPage := validatePerPage(perPagePtr)
+	return &ctypes.ResultTxSearch{Txs: txs, TotalCount: info.TotalCount}, nil
+}
 
-	page, err := validatePage(pagePtr, perPage, totalCount)
+// TxSearchCursor returns detailed information about transactions matching query, starting after
+// the cursor returned with the previous page (or from the beginning, if cursor is empty).
+func (c *FullClient) TxSearchCursor(ctx context.Context, query string, prove bool, cursor string, limit *int, orderBy string) (*types.ResultTxSearchCursor, error) {
+	opts, err := cursorSearchOptions(cursor, limit, orderBy, c.node.Store.Height())
+	if err != nil {
+		return nil, err
+	}
+	txs, info, err := c.searchTxs(ctx, query, prove, opts)
 	if err != nil {
 		return nil, err
 	}
+	res := &types.ResultTxSearchCursor{Txs: txs}
+	if info.Next != nil {
+		res.NextCursor = info.Next.Cursor()
+	}
+	return res, nil
+}
 
-	skipCount := validateSkipCount(page, perPage)
-	pageSize := min(perPage, totalCount-skipCount)
+// searchTxs searches for a page of transactions, building inclusion proofs if requested.
+func (c *FullClient) searchTxs(ctx context.Context, query string, prove bool, opts indexer.SearchOptions) ([]*ctypes.ResultTx, indexer.PageInfo, error) {
+	q, err := cmquery.New(query)
+	if err != nil {
+		return nil, indexer.PageInfo{}, err
+	}
 
-	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
+	results, info, err := c.node.TxIndexer.SearchPage(ctx, q, opts)
+	if err != nil {
+		return nil, indexer.PageInfo{}, err
+	}
 
+	proofs := newTxProofCache(c.node.Store)
+	apiResults := make([]*ctypes.ResultTx, 0, len(results))
+	for _, r := range results {
 		var proof cmtypes.TxProof
-		/*if prove {
-			block := nil                               //env.BlockStore.GetBlock(r.Height)
-			proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
-		}*/
+		if prove {
+			proof, err = proofs.proof(ctx, uint64(r.Height), r.Index) //nolint:gosec
+			if err != nil {
+				return nil, indexer.PageInfo{}, err
+			}
+		}
 
 		apiResults = append(apiResults, &ctypes.ResultTx{
 			Hash:     cmtypes.Tx(r.Tx).Hash(),
@@ -647,59 +664,79 @@ func (c *FullClient) TxSearch(ctx context.Context, query string, prove bool, pag
 		})
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	return apiResults, info, nil
 }
 
 // BlockSearch defines a method to search for a paginated set of blocks by
 // BeginBlock and EndBlock event search criteria.
-func (c *FullClient) BlockSearch(ctx context.Context, query string, page, perPage *int, orderBy string) (*ctypes.ResultBlockSearch, error) {
-	q, err := cmquery.New(query)
+// All matching blocks are found to count them, see BlockSearchCursor for pages found by scanning
+// only the heights of the page.
+func (c *FullClient) BlockSearch(ctx context.Context, query string, pagePtr, perPagePtr *int, orderBy string) (*ctypes.ResultBlockSearch, error) {
+	order, err := indexer.ParseOrder(orderBy)
 	if err != nil {
 		return nil, err
 	}
+	perPage := validatePerPage(perPagePtr)
+	page := 1
+	if pagePtr != nil {
+		page = *pagePtr
+	}
 
-	results, err := c.node.BlockIndexer.Search(ctx, q)
+	blocks, info, err := c.searchBlocks(ctx, query, indexer.SearchOptions{
+		Order:  order,
+		Offset: validateSkipCount(page, perPage),
+		Limit:  perPage,
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Sort the results
-	switch orderBy {
-	case "desc":
-		sort.Slice(results, func(i, j int) bool {
-			return results[i] > results[j]
-		})
-
-	case "asc", "":
-		sort.Slice(results, func(i, j int) bool {
-			return results[i] < results[j]
-		})
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	if _, err := validatePage(pagePtr, perPage, info.TotalCount); err != nil {
+		return nil, err
 	}
 
-	// Paginate
-	totalCount := len(results)
-	perPageVal := validatePerPage(perPage)
+	return &ctypes.ResultBlockSearch{Blocks: blocks, TotalCount: info.TotalCount}, nil
+}
 
-	pageVal, err := validatePage(page, perPageVal, totalCount)
+// BlockSearchCursor searches for blocks by BeginBlock and EndBlock event search criteria, starting after
+// the cursor returned with the previous page (or from the beginning, if cursor is empty).
+func (c *FullClient) BlockSearchCursor(ctx context.Context, query string, cursor string, limit *int, orderBy string) (*types.ResultBlockSearchCursor, error) {
+	opts, err := cursorSearchOptions(cursor, limit, orderBy, c.node.Store.Height())
 	if err != nil {
 		return nil, err
 	}
+	blocks, info, err := c.searchBlocks(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.ResultBlockSearchCursor{Blocks: blocks}
+	if info.Next != nil {
+		res.NextCursor = info.Next.Cursor()
+	}
+	return res, nil
+}
 
-	skipCount := validateSkipCount(pageVal, perPageVal)
-	pageSize := min(perPageVal, totalCount-skipCount)
+// searchBlocks searches for a page of blocks.
+func (c *FullClient) searchBlocks(ctx context.Context, query string, opts indexer.SearchOptions) ([]*ctypes.ResultBlock, indexer.PageInfo, error) {
+	q, err := cmquery.New(query)
+	if err != nil {
+		return nil, indexer.PageInfo{}, err
+	}
+
+	results, info, err := c.node.BlockIndexer.SearchPage(ctx, q, opts)
+	if err != nil {
+		return nil, indexer.PageInfo{}, err
+	}
 
 	// Fetch the blocks
-	blocks := make([]*ctypes.ResultBlock, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		header, data, err := c.node.Store.GetBlockData(ctx, uint64(results[i]))
+	blocks := make([]*ctypes.ResultBlock, 0, len(results))
+	for _, height := range results {
+		header, data, err := c.node.Store.GetBlockData(ctx, uint64(height)) //nolint:gosec
 		if err != nil {
-			return nil, err
+			return nil, indexer.PageInfo{}, err
 		}
 		block, err := abciconv.ToABCIBlock(header, data)
 		if err != nil {
-			return nil, err
+			return nil, indexer.PageInfo{}, err
 		}
 		blocks = append(blocks, &ctypes.ResultBlock{
 			Block: block,
@@ -709,7 +746,7 @@ func (c *FullClient) BlockSearch(ctx context.Context, query string, page, perPag
 		})
 	}
 
-	return &ctypes.ResultBlockSearch{Blocks: blocks, TotalCount: totalCount}, nil
+	return blocks, info, nil
 }
 
 // Status returns detailed information about current status of the node.
@@ -767,6 +804,12 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 	}
 	txIndexerStatus := "on"
 
+	// Go IBC relayer's legacy encoding check requires catching_up to be false
+	catchingUp := false
+	if !c.node.nodeConfig.LegacyCatchingUp {
+		catchingUp = c.node.blockManager.GetSyncStatus().CatchingUp
+	}
+
 	result := &ctypes.ResultStatus{
 		NodeInfo: corep2p.DefaultNodeInfo{
 			ProtocolVersion: defaultProtocolVersion,
@@ -789,7 +832,7 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 			EarliestAppHash:     cmbytes.HexBytes(initialHeader.AppHash),
 			EarliestBlockHeight: int64(initialHeader.Height()),
 			EarliestBlockTime:   initialHeader.Time(),
-			CatchingUp:          false, // hard-code this to "false" to pass Go IBC relayer's legacy encoding check
+			CatchingUp:          catchingUp,
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     validator.Address,
@@ -800,10 +843,17 @@ func (c *FullClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
 	return result, nil
 }
 
-// BroadcastEvidence is not yet implemented.
+// BroadcastEvidence verifies and persists equivocation evidence of the sequencer, and gossips it to peers.
 func (c *FullClient) BroadcastEvidence(ctx context.Context, evidence cmtypes.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	ev, ok := evidence.(*types.EquivocationEvidence)
+	if !ok {
+		return nil, fmt.Errorf("unsupported evidence type: %T", evidence)
+	}
+	if err := c.node.blockManager.BroadcastEquivocationEvidence(ctx, ev); err != nil {
+		return nil, fmt.Errorf("invalid evidence: %w", err)
+	}
 	return &ctypes.ResultBroadcastEvidence{
-		Hash: evidence.Hash(),
+		Hash: ev.Hash(),
 	}, nil
 }
 
@@ -874,6 +924,124 @@ func (c *FullClient) HeaderByHash(ctx context.Context, hash cmbytes.HexBytes) (*
 	return &ctypes.ResultHeader{Header: &blockMeta.Header}, nil
 }
 
+// DAIncludedHeight returns the rollup height up to which all blocks are included in the DA layer.
+func (c *FullClient) DAIncludedHeight(ctx context.Context) (*types.ResultDAIncludedHeight, error) {
+	return &types.ResultDAIncludedHeight{Height: c.node.blockManager.GetDAIncludedHeight()}, nil
+}
+
+// BlockFinality returns the finality status of the block at given height.
+//
+// Blocks are soft-confirmed once produced by the sequencer and final once
+// included in the DA layer. If height is nil, it returns the status of the last known block.
+func (c *FullClient) BlockFinality(ctx context.Context, heightPtr *int64) (*types.ResultBlockFinality, error) {
+	height := c.normalizeHeight(heightPtr)
+	header, _, err := c.node.Store.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("block at height %d not found: %w", height, err)
+	}
+	hash := header.Hash()
+	status := types.FinalityStatusSoftConfirmed
+	if height <= c.node.blockManager.GetDAIncludedHeight() || c.node.blockManager.IsDAIncluded(hash) {
+		status = types.FinalityStatusDAIncluded
+	}
+	return &types.ResultBlockFinality{
+		Height: height,
+		Hash:   cmbytes.HexBytes(hash),
+		Status: status,
+	}, nil
+}
+
+// BlockTxProofs returns inclusion proofs of all transactions in block at given height.
+// If height is nil, the latest block is used.
+func (c *FullClient) BlockTxProofs(ctx context.Context, heightPtr *int64) (*types.ResultBlockTxProofs, error) {
+	height := c.normalizeHeight(heightPtr)
+	proofs, err := newTxProofCache(c.node.Store).blockProofs(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.ResultBlockTxProofs{
+		Height: height,
+		Proofs: make([]cmtypes.TxProof, len(proofs)),
+	}
+	for i, proof := range proofs {
+		res.Proofs[i] = proof.ToCometTxProof()
+	}
+	return res, nil
+}
+
+// EquivocationEvidence returns sequencer equivocation evidence found at given height.
+// If height is nil, it returns all known equivocation evidence.
+func (c *FullClient) EquivocationEvidence(ctx context.Context, heightPtr *int64) (*types.ResultEquivocationEvidence, error) {
+	if heightPtr == nil {
+		evidence, err := c.node.blockManager.ListEquivocationEvidence(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ResultEquivocationEvidence{Evidence: evidence}, nil
+	}
+	ev, err := c.node.blockManager.GetEquivocationEvidence(ctx, uint64(*heightPtr))
+	if errors.Is(err, ds.ErrNotFound) {
+		return &types.ResultEquivocationEvidence{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.ResultEquivocationEvidence{Evidence: []*types.EquivocationEvidence{ev}}, nil
+}
+
+// FraudProofs returns the fraud proof of the block at given height, generated while syncing or
+// received from the network. If height is nil, it returns all known fraud proofs.
+func (c *FullClient) FraudProofs(ctx context.Context, heightPtr *int64) (*types.ResultFraudProofs, error) {
+	if heightPtr == nil {
+		proofs, err := c.node.blockManager.ListFraudProofs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ResultFraudProofs{FraudProofs: proofs}, nil
+	}
+	proof, err := c.node.blockManager.GetFraudProof(ctx, uint64(*heightPtr))
+	if errors.Is(err, ds.ErrNotFound) {
+		return &types.ResultFraudProofs{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.ResultFraudProofs{FraudProofs: []*types.FraudProof{proof}}, nil
+}
+
+// PendingHeaders returns the number and heights of headers not yet submitted to the DA layer.
+func (c *FullClient) PendingHeaders(ctx context.Context) (*types.ResultPendingHeaders, error) {
+	res := c.node.blockManager.GetPendingHeaders()
+	return &res, nil
+}
+
+// DAStatus returns the status of DA retrieval and submission.
+func (c *FullClient) DAStatus(ctx context.Context) (*types.ResultDAStatus, error) {
+	res := c.node.blockManager.GetDAStatus()
+	return &res, nil
+}
+
+// BatchQueue returns the number of sequencer batches waiting for block production.
+func (c *FullClient) BatchQueue(ctx context.Context) (*types.ResultBatchQueue, error) {
+	return &types.ResultBatchQueue{Depth: c.node.blockManager.GetBatchQueueDepth()}, nil
+}
+
+// SyncStatus returns heights of the block store, P2P sync stores and DA.
+func (c *FullClient) SyncStatus(ctx context.Context) (*types.ResultSyncStatus, error) {
+	res := c.node.blockManager.GetSyncStatus()
+	return &res, nil
+}
+
+// Liveness checks node components that can't recover without restarting the node.
+func (c *FullClient) Liveness(ctx context.Context) (*types.ResultHealth, error) {
+	return c.node.Liveness(ctx), nil
+}
+
+// Readiness checks all node components required to serve requests.
+func (c *FullClient) Readiness(ctx context.Context) (*types.ResultHealth, error) {
+	return c.node.Readiness(ctx), nil
+}
+
 func (c *FullClient) eventsRoutine(sub cmtypes.Subscription, subscriber string, q cmpubsub.Query, outc chan<- ctypes.ResultEvent) {
 	defer close(outc)
 	for {
@@ -989,6 +1157,23 @@ func validatePage(pagePtr *int, perPage, totalCount int) (int, error) {
 	return page, nil
 }
 
+// cursorSearchOptions creates options of search starting after the cursor.
+func cursorSearchOptions(cursor string, limit *int, orderBy string, maxHeight uint64) (indexer.SearchOptions, error) {
+	order, err := indexer.ParseOrder(orderBy)
+	if err != nil {
+		return indexer.SearchOptions{}, err
+	}
+	opts := indexer.SearchOptions{Order: order, Limit: validatePerPage(limit), MaxHeight: int64(maxHeight)} //nolint:gosec
+	if cursor != "" {
+		after, err := indexer.ParseCursor(cursor)
+		if err != nil {
+			return indexer.SearchOptions{}, err
+		}
+		opts.After = &after
+	}
+	return opts, nil
+}
+
 func validateSkipCount(page, perPage int) int {
 	skipCount := (page - 1) * perPage
 	if skipCount < 0 {
@@ -29,6 +29,7 @@ import (
 	"github.com/cometbft/cometbft/light"
 
 	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -64,7 +65,7 @@ func getRPC(t *testing.T) (*mocks.Application, *FullClient) {
 	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
 	ctx := context.Background()
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	node, err := newFullNode(
 		ctx,
@@ -169,7 +170,7 @@ func TestGenesisChunked(t *testing.T) {
 	mockApp := &mocks.Application{}
 	mockApp.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{}, nil)
 	privKey, _, _ := crypto.GenerateEd25519Key(crand.Reader)
-	signingKey, _, _ := crypto.GenerateEd25519Key(crand.Reader)
+	signingKey, _ := signer.NewLocalSigner(ed25519.GenPrivKey(), "")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	n, _ := newFullNode(ctx, config.NodeConfig{DAAddress: MockDAAddress, DANamespace: MockDANamespace}, privKey, signingKey, proxy.NewLocalClientCreator(mockApp), genDoc, DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), test.NewFileLogger(t))
@@ -326,6 +327,36 @@ func TestGetBlock(t *testing.T) {
 	assert.NotNil(blockResp.Block)
 }
 
+func TestBlockFinality(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	mockApp, rpc := getRPC(t)
+	mockApp.On("FinalizeBlock", mock.Anything, mock.Anything).Return(finalizeBlockResponse)
+	mockApp.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+
+	startNodeWithCleanup(t, rpc.node)
+	ctx := context.Background()
+	header, data := types.GetRandomBlock(1, 10)
+	err := rpc.node.Store.SaveBlockData(ctx, header, data, &types.Signature{})
+	rpc.node.Store.SetHeight(ctx, header.Height())
+	require.NoError(err)
+
+	included, err := rpc.DAIncludedHeight(ctx)
+	require.NoError(err)
+	assert.Equal(uint64(0), included.Height)
+
+	finality, err := rpc.BlockFinality(ctx, nil)
+	require.NoError(err)
+	assert.Equal(header.Height(), finality.Height)
+	assert.EqualValues(header.Hash(), finality.Hash)
+	assert.Equal(types.FinalityStatusSoftConfirmed, finality.Status)
+
+	missing := int64(100)
+	_, err = rpc.BlockFinality(ctx, &missing)
+	assert.Error(err)
+}
+
 func TestGetCommit(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
@@ -493,6 +524,46 @@ func TestBlockSearch(t *testing.T) {
 	}
 }
 
+func TestBlockSearchCursor(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	mockApp, rpc := getRPC(t)
+	mockApp.On("FinalizeBlock", mock.Anything, mock.Anything).Return(finalizeBlockResponse)
+	mockApp.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+
+	ctx := context.Background()
+	heights := []int64{1, 2, 3, 4, 5, 6, 7}
+	for _, h := range heights {
+		header, data := types.GetRandomBlock(uint64(h), 5)
+		err := rpc.node.Store.SaveBlockData(ctx, header, data, &types.Signature{})
+		require.NoError(err)
+	}
+	indexBlocks(t, rpc, heights)
+	rpc.node.Store.SetHeight(ctx, 7)
+
+	limit := 3
+	var found []int64
+	cursor := ""
+	for {
+		result, err := rpc.BlockSearchCursor(ctx, "block.height >= 2", cursor, &limit, "desc")
+		require.NoError(err)
+		assert.LessOrEqual(len(result.Blocks), limit)
+		for _, b := range result.Blocks {
+			found = append(found, b.Block.Height)
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+	assert.Equal([]int64{7, 6, 5, 4, 3, 2}, found)
+
+	_, err := rpc.BlockSearchCursor(ctx, "block.height >= 2", "invalid", &limit, "")
+	assert.Error(err)
+	_, err = rpc.BlockSearchCursor(ctx, "block.height >= 2", "", &limit, "random")
+	assert.Error(err)
+}
+
 func TestGetBlockByHash(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -548,7 +619,7 @@ func TestTx(t *testing.T) {
 	mockApp.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
 	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -596,6 +667,55 @@ func TestTx(t *testing.T) {
 	assert.Equal(fmt.Errorf("tx (%X) not found", tx2.Hash()), errTx)
 }
 
+func TestTxSearchProofs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, rpc := getRPC(t)
+	ctx := context.Background()
+	for height := uint64(1); height <= 2; height++ {
+		header, data := types.GetRandomBlock(height, 3)
+		require.NoError(rpc.node.Store.SaveBlockData(ctx, header, data, &types.Signature{}))
+		rpc.node.Store.SetHeight(ctx, height)
+		for i, tx := range data.Txs {
+			require.NoError(rpc.node.TxIndexer.Index(&abci.TxResult{
+				Height: int64(height), //nolint:gosec
+				Index:  uint32(i),     //nolint:gosec
+				Tx:     tx,
+			}))
+		}
+	}
+
+	res, err := rpc.TxSearch(ctx, "tx.height > 0", true, nil, nil, "desc")
+	require.NoError(err)
+	require.Len(res.Txs, 6)
+	for _, tx := range res.Txs {
+		assert.EqualValues(tx.Tx, tx.Proof.Data)
+		assert.NoError(tx.Proof.Validate(tx.Proof.RootHash))
+	}
+	assert.Equal(res.Txs[0].Proof.RootHash, res.Txs[2].Proof.RootHash)
+	assert.NotEqual(res.Txs[0].Proof.RootHash, res.Txs[3].Proof.RootHash)
+
+	res, err = rpc.TxSearch(ctx, "tx.height > 0", false, nil, nil, "asc")
+	require.NoError(err)
+	assert.Empty(res.Txs[0].Proof.RootHash)
+
+	proofs, err := rpc.BlockTxProofs(ctx, nil)
+	require.NoError(err)
+	assert.Equal(uint64(2), proofs.Height)
+	require.Len(proofs.Proofs, 3)
+	for i, proof := range proofs.Proofs {
+		tx, err := rpc.Tx(ctx, proof.Data.Hash(), true)
+		require.NoError(err)
+		assert.Equal(uint32(i), tx.Index) //nolint:gosec
+		assert.Equal(proof, tx.Proof)
+	}
+
+	height := int64(3)
+	_, err = rpc.BlockTxProofs(ctx, &height)
+	assert.Error(err)
+}
+
 func TestUnconfirmedTxs(t *testing.T) {
 	tx1 := cmtypes.Tx("tx1")
 	tx2 := cmtypes.Tx("another tx")
@@ -789,7 +909,7 @@ func TestMempool2Nodes(t *testing.T) {
 	require := require.New(t)
 
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey1, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey1, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 
 	app := &mocks.Application{}
@@ -801,7 +921,7 @@ func TestMempool2Nodes(t *testing.T) {
 	app.On("CheckTx", mock.Anything, &abci.RequestCheckTx{Tx: []byte("good"), Type: abci.CheckTxType_Recheck}).Return(&abci.ResponseCheckTx{Code: 0}, nil).Maybe()
 	key1, _, _ := crypto.GenerateEd25519Key(crand.Reader)
 	key2, _, _ := crypto.GenerateEd25519Key(crand.Reader)
-	signingKey2, _, _ := crypto.GenerateEd25519Key(crand.Reader)
+	signingKey2, _ := signer.NewLocalSigner(ed25519.GenPrivKey(), "")
 
 	id1, err := peer.IDFromPrivateKey(key1)
 	require.NoError(err)
@@ -881,7 +1001,7 @@ func TestStatus(t *testing.T) {
 	app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
 	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	pubKey := genesisDoc.Validators[0].PubKey
 
@@ -1025,7 +1145,7 @@ func TestFutureGenesisTime(t *testing.T) {
 	mockApp.On("CheckTx", mock.Anything, mock.Anything).Return(&abci.ResponseCheckTx{}, nil)
 	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	genesisTime := time.Now().Local().Add(time.Second * time.Duration(1))
 	ctx, cancel := context.WithCancel(context.Background())
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	mrand "math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -20,6 +21,7 @@ import (
 
 	testutils "github.com/celestiaorg/utils/test"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
@@ -31,6 +33,7 @@ import (
 	goDA "github.com/rollkit/go-da"
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
+	"github.com/rollkit/rollkit/signer"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -56,7 +59,7 @@ func TestAggregatorMode(t *testing.T) {
 
 	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	blockManagerConfig := config.BlockManagerConfig{
 		BlockTime: 1 * time.Second,
@@ -85,6 +88,33 @@ func TestAggregatorMode(t *testing.T) {
 	}()
 }
 
+// TestStandbyAggregatorPromotion starts a standby aggregator without an active one; it should be
+// promoted and produce blocks.
+func TestStandbyAggregatorPromotion(t *testing.T) {
+	require := require.New(t)
+
+	app := getMockApplication()
+	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
+	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
+	require.NoError(err)
+	blockManagerConfig := config.BlockManagerConfig{
+		BlockTime:           100 * time.Millisecond,
+		DABlockTime:         100 * time.Millisecond,
+		Standby:             true,
+		StandbyMissedBlocks: 3,
+		LeaseFile:           filepath.Join(t.TempDir(), "lease"),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := newFullNode(ctx, config.NodeConfig{DAAddress: MockDAAddress, DANamespace: MockDANamespace, Aggregator: true, BlockManagerConfig: blockManagerConfig}, key, signingKey, proxy.NewLocalClientCreator(app), genesisDoc, DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), log.TestingLogger())
+	require.NoError(err)
+
+	startNodeWithCleanup(t, node)
+	require.NoError(waitForAtLeastNBlocks(node, 3, Store))
+	require.False(node.blockManager.IsStandby())
+}
+
 // TestTxGossipingAndAggregation setups a network of nodes, with single aggregator and multiple producers.
 // Nodes should gossip transactions and aggregator node should produce blocks.
 func TestTxGossipingAndAggregation(t *testing.T) {
@@ -332,7 +362,7 @@ func TestChangeValSet(t *testing.T) {
 	// tmpubKey1
 	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
 	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(err)
 	tmPubKey1, err := cryptoenc.PubKeyToProto(genesisDoc.Validators[0].PubKey)
 	require.NoError(err)
@@ -395,7 +425,7 @@ func TestChangeValSet(t *testing.T) {
 	// stop node 1
 	require.NoError(node1.Stop())
 
-	signingKey2, err := types.PrivKeyToSigningKey(key2)
+	signingKey2, err := signer.NewLocalSigner(key2, "")
 	assert.NoError(err)
 	node2, err := NewNode(ctx, config.NodeConfig{
 		DAAddress:          MockDAAddress,
@@ -489,6 +519,39 @@ func TestHeaderExchange(t *testing.T) {
 	t.Run("SingleAggregatorSingleFullNodeSingleLightNode", testSingleAggregatorSingleFullNodeSingleLightNode)
 }
 
+// TestIntermediateStateRootsLastBlockID checks that the sequencer and full nodes agree on the last
+// block ID, as intermediate state roots change the header hash after execution.
+func TestIntermediateStateRootsLastBlockID(t *testing.T) {
+	require := require.New(t)
+
+	aggCtx, aggCancel := context.WithCancel(context.Background())
+	defer aggCancel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bmConfig := getBMConfig()
+	bmConfig.IntermediateStateRoots = true
+	nodes, _ := createNodes(aggCtx, ctx, 2, bmConfig, types.TestChainID, false, t)
+	sequencer, fullNode := nodes[0], nodes[1]
+
+	startNodeWithCleanup(t, sequencer)
+	require.NoError(waitForFirstBlock(sequencer, Store))
+	startNodeWithCleanup(t, fullNode)
+	require.NoError(waitForAtLeastNBlocks(fullNode, 2, Store))
+
+	fullNodeState, err := fullNode.Store.GetState(ctx)
+	require.NoError(err)
+	header, data, err := sequencer.Store.GetBlockData(ctx, fullNodeState.LastBlockHeight)
+	require.NoError(err)
+	require.NotEmpty(data.IntermediateStateRoots.RawRootsList)
+	require.Equal(cmbytes.HexBytes(header.Hash()), fullNodeState.LastBlockID.Hash)
+
+	sequencerState, err := sequencer.Store.GetState(ctx)
+	require.NoError(err)
+	header, _, err = sequencer.Store.GetBlockData(ctx, sequencerState.LastBlockHeight)
+	require.NoError(err)
+	require.Equal(cmbytes.HexBytes(header.Hash()), sequencerState.LastBlockID.Hash)
+}
+
 func TestSubmitBlocksToDA(t *testing.T) {
 	require := require.New(t)
 
@@ -1051,6 +1114,11 @@ func createNode(ctx context.Context, n int, aggregator bool, isLight bool, keys
 		},
 	}
 
+	privKeyBytes, err := keys[n].Raw()
+	require.NoError(err)
+	signingKey, err := signer.NewLocalSigner(ed25519.PrivKey(privKeyBytes), "")
+	require.NoError(err)
+
 	genesis := &cmtypes.GenesisDoc{ChainID: chainID, Validators: genesisValidators}
 	// TODO: need to investigate why this needs to be done for light nodes
 	genesis.InitialHeight = 1
@@ -1066,7 +1134,7 @@ func createNode(ctx context.Context, n int, aggregator bool, isLight bool, keys
 			SequencerAddress:   MockSequencerAddress,
 		},
 		keys[n],
-		keys[n],
+		signingKey,
 		proxy.NewLocalClientCreator(app),
 		genesis,
 		DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()),
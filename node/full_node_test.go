@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmconfig "github.com/cometbft/cometbft/config"
 	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/log"
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
 	cmtypes "github.com/cometbft/cometbft/types"
@@ -32,6 +34,15 @@ import (
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/da"
 	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/state/indexer"
+	blockidxkv "github.com/rollkit/rollkit/state/indexer/block/kv"
+	blockidxnull "github.com/rollkit/rollkit/state/indexer/block/null"
+	"github.com/rollkit/rollkit/state/indexer/sink/psql"
+	"github.com/rollkit/rollkit/state/txindex"
+	"github.com/rollkit/rollkit/state/txindex/kv"
+	"github.com/rollkit/rollkit/state/txindex/null"
+	"github.com/rollkit/rollkit/store"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/test/mocks"
 	"github.com/rollkit/rollkit/types"
@@ -410,7 +421,7 @@ func createAggregatorWithPersistence(ctx context.Context, dbPath string, dalc *d
 	t.Helper()
 
 	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(t, err)
 
 	app := getMockApplication()
@@ -459,7 +470,7 @@ func createAggregatorWithApp(ctx context.Context, app abci.Application, voteExte
 		Version:   cmtypes.DefaultVersionParams(),
 		ABCI:      cmtypes.ABCIParams{VoteExtensionsEnableHeight: voteExtensionEnableHeight},
 	}
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	require.NoError(t, err)
 
 	node, err := NewNode(
@@ -541,3 +552,43 @@ func verifyMempoolSize(node *FullNode, t *testing.T) {
 		return fmt.Errorf("expected size %v, got size %v", expectedSize, actualSize)
 	}))
 }
+
+func TestIndexerSelection(t *testing.T) {
+	ctx := context.Background()
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	eventBus := cmtypes.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer func() { require.NoError(t, eventBus.Stop()) }()
+
+	cases := []struct {
+		name         string
+		conf         config.NodeConfig
+		txIndexer    txindex.TxIndexer
+		blockIndexer indexer.BlockIndexer
+		err          string
+	}{
+		{"default", config.NodeConfig{}, &kv.TxIndex{}, &blockidxkv.BlockerIndexer{}, ""},
+		{"kv", config.NodeConfig{Indexer: config.IndexerKV}, &kv.TxIndex{}, &blockidxkv.BlockerIndexer{}, ""},
+		{"null", config.NodeConfig{Indexer: config.IndexerNull}, &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, ""},
+		{"psql", config.NodeConfig{Indexer: config.IndexerPsql, IndexerPsqlConn: "postgres://localhost/rollkit"}, psql.TxIndex{}, psql.BlockIndexer{}, ""},
+		{"psql without connection", config.NodeConfig{Indexer: config.IndexerPsql}, nil, nil, "psql connection settings cannot be empty"},
+		{"unknown", config.NodeConfig{Indexer: "elastic"}, nil, nil, `unsupported indexer "elastic"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service, txIndexer, blockIndexer, err := createAndStartIndexerService(ctx, c.conf, "test", kvStore, eventBus, log.NewNopLogger())
+			if c.err != "" {
+				assert.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, c.txIndexer, txIndexer)
+			assert.IsType(t, c.blockIndexer, blockIndexer)
+			require.NoError(t, service.Stop())
+			if closer, ok := txIndexer.(io.Closer); ok {
+				assert.NoError(t, closer.Close())
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmconfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestAggregatorHalt(t *testing.T) {
+	cases := []struct {
+		name           string
+		haltHeight     uint64
+		finalizeBlock  func(context.Context, *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error)
+		expectedHeight uint64
+		expectedStop   error
+		expectedReason error
+	}{
+		{
+			name:           "halt height",
+			haltHeight:     3,
+			finalizeBlock:  finalizeBlockResponse,
+			expectedHeight: 3,
+			expectedStop:   block.ErrHalted,
+			expectedReason: block.ErrHaltHeight,
+		},
+		{
+			name: "halt scheduled by application",
+			finalizeBlock: func(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+				resp, err := finalizeBlockResponse(ctx, req)
+				if req.Height == 2 {
+					resp.Events = []abci.Event{{
+						Type:       block.HaltEventType,
+						Attributes: []abci.EventAttribute{{Key: block.HaltEventHeightKey, Value: "4"}},
+					}}
+				}
+				return resp, err
+			},
+			expectedHeight: 4,
+			expectedStop:   block.ErrHalted,
+			expectedReason: block.ErrAppHalt,
+		},
+		{
+			name: "application error",
+			finalizeBlock: func(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+				if req.Height == 3 {
+					return nil, errors.New("upgrade needed")
+				}
+				return finalizeBlockResponse(ctx, req)
+			},
+			expectedHeight: 2,
+			// application errors are not planned halts
+			expectedStop:   block.ErrAppFailed,
+			expectedReason: state.ErrFinalizeBlock,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require := require.New(t)
+			assert := assert.New(t)
+
+			app := &mocks.Application{}
+			app.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{}, nil)
+			app.On("CheckTx", mock.Anything, mock.Anything).Return(&abci.ResponseCheckTx{}, nil)
+			app.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+			app.On("PrepareProposal", mock.Anything, mock.Anything).Return(prepareProposalResponse).Maybe()
+			app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
+			app.On("FinalizeBlock", mock.Anything, mock.Anything).Return(c.finalizeBlock)
+
+			key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
+			genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
+			signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
+			require.NoError(err)
+			blockManagerConfig := config.BlockManagerConfig{
+				BlockTime:   100 * time.Millisecond,
+				DABlockTime: 100 * time.Millisecond,
+				HaltHeight:  c.haltHeight,
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			node, err := newFullNode(ctx, config.NodeConfig{DAAddress: MockDAAddress, DANamespace: MockDANamespace, Aggregator: true, BlockManagerConfig: blockManagerConfig}, key, signingKey, proxy.NewLocalClientCreator(app), genesisDoc, DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), log.TestingLogger())
+			require.NoError(err)
+			startNodeWithCleanup(t, node)
+
+			select {
+			case <-node.Halted():
+			case <-time.After(10 * time.Second):
+				t.Fatal("node didn't halt")
+			}
+			assert.ErrorIs(node.HaltReason(), c.expectedStop)
+			assert.ErrorIs(node.HaltReason(), c.expectedReason)
+			assert.Equal(c.expectedHeight, node.Store.Height())
+			// blocks produced before the halt are submitted to DA
+			assert.Zero(node.blockManager.GetPendingHeaders().Count)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// Names of components reported by health checks.
+const (
+	HealthABCI      = "abci"
+	HealthDA        = "da"
+	HealthSequencer = "sequencer"
+	HealthP2P       = "p2p"
+	HealthBlockAge  = "block_age"
+	HealthIndexer   = "indexer"
+	HealthSync      = "sync"
+)
+
+// maxIndexerLagScan limits the number of heights checked to report indexer lag, if the check is disabled.
+const maxIndexerLagScan = 100
+
+type healthCheck func(ctx context.Context) types.ComponentHealth
+
+// Liveness checks components that can't recover without restarting the node: connection to ABCI
+// application and (if configured) the age of the latest block.
+func (n *FullNode) Liveness(ctx context.Context) *types.ResultHealth {
+	return n.runHealthChecks(ctx, map[string]healthCheck{
+		HealthABCI:     n.checkABCI,
+		HealthBlockAge: n.checkBlockAge,
+	})
+}
+
+// Readiness checks all components required to serve requests: ABCI application, DA layer, sequencer
+// (in aggregator mode), P2P peers, the age of the latest block, indexer lag and sync status.
+func (n *FullNode) Readiness(ctx context.Context) *types.ResultHealth {
+	checks := map[string]healthCheck{
+		HealthABCI:     n.checkABCI,
+		HealthDA:       n.checkDA,
+		HealthP2P:      n.checkP2P,
+		HealthBlockAge: n.checkBlockAge,
+		HealthIndexer:  n.checkIndexer,
+		HealthSync:     n.checkSync,
+	}
+	if n.nodeConfig.Aggregator {
+		checks[HealthSequencer] = n.checkSequencer
+	}
+	return n.runHealthChecks(ctx, checks)
+}
+
+// runHealthChecks runs checks concurrently, each of them bounded by the configured timeout.
+func (n *FullNode) runHealthChecks(ctx context.Context, checks map[string]healthCheck) *types.ResultHealth {
+	res := &types.ResultHealth{
+		Healthy:    true,
+		Components: make(map[string]types.ComponentHealth, len(checks)),
+	}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check healthCheck) {
+			defer wg.Done()
+			checkCtx, cancel := n.healthCheckContext(ctx)
+			defer cancel()
+			h := check(checkCtx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Components[name] = h
+			res.Healthy = res.Healthy && h.Healthy
+		}(name, check)
+	}
+	wg.Wait()
+	return res
+}
+
+func (n *FullNode) healthCheckContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if n.nodeConfig.HealthCheckTimeout > 0 {
+		return context.WithTimeout(ctx, n.nodeConfig.HealthCheckTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+func (n *FullNode) checkABCI(ctx context.Context) types.ComponentHealth {
+	if _, err := n.proxyApp.Query().Echo(ctx, "health"); err != nil {
+		return unhealthy(err)
+	}
+	return types.ComponentHealth{Healthy: true}
+}
+
+func (n *FullNode) checkDA(ctx context.Context) types.ComponentHealth {
+	maxBlobSize, err := n.dalc.DA.MaxBlobSize(ctx)
+	if err != nil {
+		return unhealthy(err)
+	}
+	return types.ComponentHealth{Healthy: true, Message: fmt.Sprintf("max blob size %d", maxBlobSize)}
+}
+
+// checkSequencer queries the sequencer for a non-existent batch. Rejection of the empty batch hash
+// still proves that the sequencer is reachable.
+func (n *FullNode) checkSequencer(ctx context.Context) types.ComponentHealth {
+	_, err := n.seqClient.VerifyBatch(ctx, []byte{})
+	switch status.Code(err) {
+	case codes.OK, codes.InvalidArgument, codes.NotFound:
+		return types.ComponentHealth{Healthy: true}
+	}
+	return unhealthy(err)
+}
+
+func (n *FullNode) checkP2P(_ context.Context) types.ComponentHealth {
+	peers := uint64(len(n.p2pClient.Peers()))
+	return types.ComponentHealth{
+		Healthy: peers >= n.nodeConfig.HealthMinPeers,
+		Message: fmt.Sprintf("%d peers connected, %d required", peers, n.nodeConfig.HealthMinPeers),
+	}
+}
+
+func (n *FullNode) checkBlockAge(_ context.Context) types.ComponentHealth {
+	if n.Store.Height() == 0 {
+		return types.ComponentHealth{Healthy: true, Message: "no blocks yet"}
+	}
+	age := time.Since(n.blockManager.GetLastBlockTime()).Truncate(time.Millisecond)
+	maxAge := n.nodeConfig.HealthMaxBlockAge
+	return types.ComponentHealth{
+		Healthy: maxAge == 0 || age <= maxAge,
+		Message: fmt.Sprintf("latest block is %s old", age),
+	}
+}
+
+// checkIndexer reports the number of latest blocks not yet indexed.
+func (n *FullNode) checkIndexer(_ context.Context) types.ComponentHealth {
+	maxLag := n.nodeConfig.HealthMaxIndexerLag
+	scan := uint64(maxIndexerLagScan)
+	if maxLag > 0 {
+		scan = maxLag + 1
+	}
+
+	height := n.Store.Height()
+	lag := uint64(0)
+	for ; lag < scan && lag < height; lag++ {
+		indexed, err := n.BlockIndexer.Has(int64(height - lag)) //nolint:gosec
+		if err != nil {
+			return unhealthy(err)
+		}
+		if indexed {
+			break
+		}
+	}
+	if lag == scan {
+		return types.ComponentHealth{
+			Healthy: maxLag == 0,
+			Message: fmt.Sprintf("at least %d blocks not indexed", lag),
+		}
+	}
+	return types.ComponentHealth{Healthy: true, Message: fmt.Sprintf("%d blocks not indexed", lag)}
+}
+
+func (n *FullNode) checkSync(_ context.Context) types.ComponentHealth {
+	syncStatus := n.blockManager.GetSyncStatus()
+	return types.ComponentHealth{
+		Healthy: !syncStatus.CatchingUp,
+		Message: fmt.Sprintf("%d blocks behind", syncStatus.BlocksBehind),
+	}
+}
+
+func unhealthy(err error) types.ComponentHealth {
+	return types.ComponentHealth{Healthy: false, Message: err.Error()}
+}
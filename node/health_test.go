@@ -0,0 +1,75 @@
+package node
+
+import (
+	"context"
+	crand "crypto/rand"
+	"testing"
+	"time"
+
+	cmconfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
+	test "github.com/rollkit/rollkit/test/log"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestHealthChecks(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	app := setupMockApplication()
+	key, _, _ := crypto.GenerateEd25519Key(crand.Reader)
+	genesisDoc, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
+	require.NoError(err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := newFullNode(ctx,
+		config.NodeConfig{
+			DAAddress:   MockDAAddress,
+			DANamespace: MockDANamespace,
+			Aggregator:  true,
+			BlockManagerConfig: config.BlockManagerConfig{
+				BlockTime: 100 * time.Millisecond,
+			},
+			HealthConfig: config.HealthConfig{
+				HealthMaxBlockAge:  time.Minute,
+				HealthMinPeers:     1,
+				HealthCheckTimeout: time.Second,
+			},
+			SequencerAddress: MockSequencerAddress,
+		},
+		key, signingKey, proxy.NewLocalClientCreator(app),
+		genesisDoc,
+		DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()),
+		test.NewFileLogger(t))
+	require.NoError(err)
+
+	startNodeWithCleanup(t, node)
+	require.NoError(waitForAtLeastNBlocks(node, 2, Store))
+
+	liveness := node.Liveness(ctx)
+	assert.True(liveness.Healthy)
+	assert.Len(liveness.Components, 2)
+
+	readiness := node.Readiness(ctx)
+	assert.False(readiness.Healthy)
+	assert.False(readiness.Components[HealthP2P].Healthy)
+	for _, name := range []string{HealthABCI, HealthDA, HealthSequencer, HealthBlockAge, HealthIndexer, HealthSync} {
+		assert.True(readiness.Components[name].Healthy, name, readiness.Components[name].Message)
+	}
+
+	node.nodeConfig.HealthMinPeers = 0
+	assert.True(node.Readiness(ctx).Healthy)
+
+	node.nodeConfig.HealthMaxBlockAge = time.Nanosecond
+	liveness = node.Liveness(ctx)
+	assert.False(liveness.Healthy)
+	assert.False(liveness.Components[HealthBlockAge].Healthy)
+	assert.True(liveness.Components[HealthABCI].Healthy)
+}
@@ -0,0 +1,21 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+)
+
+// OpenReadOnlyStore opens the store of a stopped node in read-only mode, for inspection.
+func OpenReadOnlyStore(nodeConfig config.NodeConfig) (*store.DefaultStore, error) {
+	if nodeConfig.RootDir == "" && nodeConfig.DBPath == "" {
+		return nil, errors.New("database path is not configured")
+	}
+	baseKV, err := store.NewDefaultReadOnlyKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	return store.New(newPrefixKV(baseKV, mainPrefix)).(*store.DefaultStore), nil
+}
@@ -0,0 +1,39 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestOpenReadOnlyStore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	nodeConfig := config.NodeConfig{RootDir: t.TempDir(), DBPath: "data"}
+
+	_, err := OpenReadOnlyStore(config.NodeConfig{})
+	assert.Error(t, err)
+
+	baseKV, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s := store.New(newPrefixKV(baseKV, mainPrefix))
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(s.Close())
+
+	ro, err := OpenReadOnlyStore(nodeConfig)
+	require.NoError(err)
+	defer func() {
+		require.NoError(ro.Close())
+	}()
+	problems, err := ro.CheckConsistency(ctx, 1, 1)
+	require.NoError(err)
+	assert.Empty(t, problems)
+	assert.Error(t, ro.SaveBlockData(ctx, header, data, &header.Signature))
+}
@@ -93,6 +93,8 @@ func newLightNode(
 	}
 
 	node.P2P.SetTxValidator(node.falseValidator())
+	node.P2P.SetEvidenceValidator(node.falseValidator())
+	node.P2P.SetFraudProofValidator(node.falseValidator())
 
 	node.BaseService = *service.NewBaseService(logger, "LightNode", node)
 
@@ -11,6 +11,7 @@ import (
 	cmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
 )
 
 // Node is the interface for a rollup node
@@ -27,7 +28,7 @@ func NewNode(
 	ctx context.Context,
 	conf config.NodeConfig,
 	p2pKey crypto.PrivKey,
-	signingKey crypto.PrivKey,
+	signer signer.Signer,
 	appClient proxy.ClientCreator,
 	genesis *cmtypes.GenesisDoc,
 	metricsProvider MetricsProvider,
@@ -38,7 +39,7 @@ func NewNode(
 			ctx,
 			conf,
 			p2pKey,
-			signingKey,
+			signer,
 			appClient,
 			genesis,
 			metricsProvider,
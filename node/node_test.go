@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
 	test "github.com/rollkit/rollkit/test/log"
 	"github.com/rollkit/rollkit/types"
 
@@ -143,7 +144,7 @@ func newTestNode(ctx context.Context, t *testing.T, nodeType NodeType) (Node, cm
 	}
 	app := setupMockApplication()
 	genesis, genesisValidatorKey := types.GetGenesisWithPrivkey(types.DefaultSigningKeyType)
-	signingKey, err := types.PrivKeyToSigningKey(genesisValidatorKey)
+	signingKey, err := signer.NewLocalSigner(genesisValidatorKey, "")
 	if err != nil {
 		return nil, nil, err
 	}
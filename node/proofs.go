@@ -0,0 +1,51 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// txProofCache builds transaction inclusion proofs, loading Data of every block from the store only once.
+// It's not thread-safe, and is intended to be used within a single request.
+type txProofCache struct {
+	store  store.Store
+	proofs map[uint64][]types.TxProof
+}
+
+func newTxProofCache(store store.Store) *txProofCache {
+	return &txProofCache{
+		store:  store,
+		proofs: make(map[uint64][]types.TxProof),
+	}
+}
+
+// proof returns inclusion proof of transaction with given index in block at given height.
+func (c *txProofCache) proof(ctx context.Context, height uint64, index uint32) (cmtypes.TxProof, error) {
+	proofs, err := c.blockProofs(ctx, height)
+	if err != nil {
+		return cmtypes.TxProof{}, err
+	}
+	if uint64(index) >= uint64(len(proofs)) {
+		return cmtypes.TxProof{}, fmt.Errorf("tx index %d out of range of block at height %d", index, height)
+	}
+	return proofs[index].ToCometTxProof(), nil
+}
+
+// blockProofs returns inclusion proofs of all transactions in block at given height.
+func (c *txProofCache) blockProofs(ctx context.Context, height uint64) ([]types.TxProof, error) {
+	if proofs, ok := c.proofs[height]; ok {
+		return proofs, nil
+	}
+	_, data, err := c.store.GetBlockData(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block at height %d: %w", height, err)
+	}
+	proofs := data.Txs.Proofs()
+	c.proofs[height] = proofs
+	return proofs, nil
+}
@@ -0,0 +1,62 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/mempool"
+	"github.com/rollkit/rollkit/state"
+)
+
+// ReplayBlocks replays blocks saved by the node against the application created by clientCreator
+// and compares the results with the saved ones. The store of the node is opened in read-only mode,
+// and the node must be stopped.
+//
+// The application has to be fresh, or at the height preceding the first replayed block. If
+// opts.From is 0, replay starts after the last block of the application.
+func ReplayBlocks(ctx context.Context, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, clientCreator proxy.ClientCreator, opts block.ReplayOptions, logger log.Logger) (_ *block.ReplayResult, err error) {
+	s, err := OpenReadOnlyStore(nodeConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+
+	proxyApp, err := initProxyApp(clientCreator, logger, proxy.NopMetrics())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if stopErr := proxyApp.Stop(); stopErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to stop proxy app connections: %w", stopErr))
+		}
+	}()
+	info, err := proxyApp.Query().Info(ctx, proxy.RequestInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query application info: %w", err)
+	}
+	initialHeight := uint64(max(genesis.InitialHeight, 1))        //nolint:gosec
+	if appHeight := uint64(info.LastBlockHeight); appHeight > 0 { //nolint:gosec
+		if opts.From == 0 {
+			opts.From = appHeight + 1
+		} else if opts.From != appHeight+1 {
+			return nil, fmt.Errorf("application is at height %d, blocks can only be replayed from height %d", appHeight, appHeight+1)
+		}
+	} else if opts.From > initialHeight {
+		return nil, fmt.Errorf("application is fresh, blocks can only be replayed from the initial height %d", initialHeight)
+	}
+
+	mpool := initMempool(proxyApp, mempool.NopMetrics())
+	mpoolReaper := initMempoolReaper(mpool, []byte(genesis.ChainID), nil, logger)
+	exec := state.NewBlockExecutor(nil, genesis.ChainID, mpool, mpoolReaper, proxyApp.Consensus(), nil, 0,
+		nodeConfig.IntermediateStateRoots, logger.With("module", "BlockExecutor"), state.NopMetrics())
+	return block.ReplayBlocks(ctx, s, genesis, exec, opts)
+}
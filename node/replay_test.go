@@ -0,0 +1,88 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/state"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestReplayBlocks(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	nodeConfig := config.NodeConfig{RootDir: t.TempDir(), DBPath: "data"}
+
+	// save a chain of 3 blocks with their responses in the store of the node
+	genesis, privKey := types.GetGenesisWithPrivkey("")
+	baseKV, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s := store.New(newPrefixKV(baseKV, mainPrefix))
+	exec := state.NewBlockExecutor(nil, genesis.ChainID, nil, nil, nil, nil, 0, false, log.NewNopLogger(), state.NopMetrics())
+	st, err := types.NewFromGenesisDoc(genesis)
+	require.NoError(err)
+	header, data, _ := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1, PrivKey: privKey})
+	header.LastResultsHash = merkle.HashFromByteSlices(nil)
+	signature, err := types.GetSignature(header.Header, privKey)
+	require.NoError(err)
+	header.Signature = *signature
+	initialAppHash := header.AppHash
+	var responses []*abci.ResponseFinalizeBlock
+	for i := 1; i <= 3; i++ {
+		resp := &abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK}},
+			AppHash:   types.GetRandomBytes(32),
+		}
+		responses = append(responses, resp)
+		require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(s.SaveBlockResponses(ctx, header.Height(), resp))
+		st, err = exec.ApplyBlockResponses(st, header, data, resp)
+		require.NoError(err)
+		header, data = types.GetRandomNextBlock(header, data, privKey, resp.AppHash, 1)
+	}
+	require.NoError(s.UpdateState(ctx, st))
+	require.NoError(s.Close())
+
+	newApp := func(appHeight int64) proxy.ClientCreator {
+		app := &mocks.Application{}
+		app.On("Info", mock.Anything, mock.Anything).Return(&abci.ResponseInfo{LastBlockHeight: appHeight}, nil)
+		app.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{AppHash: initialAppHash}, nil)
+		app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
+		app.On("FinalizeBlock", mock.Anything, mock.Anything).Return(
+			func(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+				return responses[req.Height-1], nil
+			},
+		)
+		app.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+		return proxy.NewLocalClientCreator(app)
+	}
+
+	result, err := ReplayBlocks(ctx, nodeConfig, genesis, newApp(0), block.ReplayOptions{DiffResponses: true}, log.NewNopLogger())
+	require.NoError(err)
+	assert.Equal(uint64(3), result.LastHeight)
+	assert.Nil(result.Divergence)
+
+	// replay starts after the last block of the application
+	result, err = ReplayBlocks(ctx, nodeConfig, genesis, newApp(1), block.ReplayOptions{}, log.NewNopLogger())
+	require.NoError(err)
+	assert.Equal(uint64(2), result.From)
+	assert.Equal(uint64(3), result.LastHeight)
+
+	_, err = ReplayBlocks(ctx, nodeConfig, genesis, newApp(1), block.ReplayOptions{From: 3}, log.NewNopLogger())
+	assert.ErrorContains(err, "application is at height 1")
+	_, err = ReplayBlocks(ctx, nodeConfig, genesis, newApp(0), block.ReplayOptions{From: 2}, log.NewNopLogger())
+	assert.ErrorContains(err, "application is fresh")
+}
@@ -0,0 +1,113 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+// ErrAppRollback is returned by Rollback if the store was rolled back, but the application wasn't.
+var ErrAppRollback = errors.New("failed to rollback application")
+
+// Rollback removes the given number of latest blocks (with their responses, signatures and extended
+// commits) from the store of the node and restores the state after the latest remaining block.
+// It must not be called while the node is running. On restart, removed blocks are synced and
+// executed again.
+//
+// If app is not nil, application state is rolled back after the store. Otherwise, application state has
+// to be rolled back separately, to the height of the returned state (like with CometBFT rollback command).
+// If rolling back the application fails, the rolled back state is returned with the error, and
+// application state has to be rolled back manually to its height. Rolling back the store first
+// ensures the node never restarts with the application behind its state, which can't be recovered
+// from by syncing.
+func Rollback(ctx context.Context, nodeConfig config.NodeConfig, genesis *cmtypes.GenesisDoc, blocks uint64, app block.AppRollbacker) (_ types.State, err error) {
+	if blocks == 0 {
+		return types.State{}, errors.New("number of blocks to rollback must be positive")
+	}
+	s, err := openStore(nodeConfig)
+	if err != nil {
+		return types.State{}, err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+
+	st, err := s.GetState(ctx)
+	if err != nil {
+		return types.State{}, fmt.Errorf("failed to load state: %w", err)
+	}
+	// blocks can be rolled back up to the state before the initial block
+	if blocks > st.LastBlockHeight || st.LastBlockHeight-blocks+1 < st.InitialHeight {
+		return types.State{}, fmt.Errorf("cannot rollback %d blocks, last block height is %d and initial height is %d",
+			blocks, st.LastBlockHeight, st.InitialHeight)
+	}
+	height := st.LastBlockHeight - blocks
+
+	st, err = block.Rollback(ctx, s, genesis, height)
+	if err != nil {
+		return types.State{}, err
+	}
+	if app != nil {
+		if err := app.Rollback(ctx, height); err != nil {
+			return st, fmt.Errorf("%w, it has to be rolled back to height %d manually: %w", ErrAppRollback, height, err)
+		}
+	}
+	return st, nil
+}
+
+// openStore opens the store of a stopped node.
+func openStore(nodeConfig config.NodeConfig) (store.Store, error) {
+	if nodeConfig.RootDir == "" && nodeConfig.DBPath == "" {
+		return nil, errors.New("database path is not configured")
+	}
+	baseKV, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	return store.New(newPrefixKV(baseKV, mainPrefix)), nil
+}
+
+// LastSignedHeight returns the height of the last header signed by the sequencer of a stopped node.
+// If it's above the height of the state after Rollback, the aggregator refuses to produce blocks at
+// the rolled back heights, until sign state is reset by ResetSignState.
+func LastSignedHeight(ctx context.Context, nodeConfig config.NodeConfig) (_ uint64, err error) {
+	s, err := OpenReadOnlyStore(nodeConfig)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+	return block.LastSignedHeight(ctx, s)
+}
+
+// ResetSignState lowers the last signed header of a stopped node to given height, both in the store
+// and in the state file of the local signer (if stateFile is not empty), so that the aggregator
+// produces blocks at rolled back heights again. Remote signers have to be reset separately.
+//
+// It must be used only if the rolled back headers were never published, as signing different
+// headers at their heights is double signing.
+func ResetSignState(ctx context.Context, nodeConfig config.NodeConfig, stateFile string, height uint64) (err error) {
+	s, err := openStore(nodeConfig)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, s.Close())
+	}()
+	if err := block.ResetSignState(ctx, s, height); err != nil {
+		return fmt.Errorf("failed to reset sign state: %w", err)
+	}
+	if stateFile != "" {
+		return signer.ResetStateFile(stateFile, int64(height)) //nolint:gosec
+	}
+	return nil
+}
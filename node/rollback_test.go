@@ -0,0 +1,107 @@
+package node
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+)
+
+type testAppRollbacker struct {
+	height uint64
+	err    error
+}
+
+func (r *testAppRollbacker) Rollback(_ context.Context, height uint64) error {
+	r.height = height
+	return r.err
+}
+
+func TestRollback(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+	nodeConfig := config.NodeConfig{RootDir: t.TempDir(), DBPath: "data"}
+
+	// save a chain of 5 blocks in the store of the node
+	baseKV, err := store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s := store.New(newPrefixKV(baseKV, mainPrefix))
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	headers := []*types.SignedHeader{header}
+	for i := 1; i <= 5; i++ {
+		require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+		s.SetHeight(ctx, header.Height())
+		header, data = types.GetRandomNextBlock(header, data, privKey, types.GetRandomBytes(32), 1)
+		headers = append(headers, header)
+	}
+	require.NoError(s.UpdateState(ctx, types.State{
+		ChainID:         headers[4].ChainID(),
+		InitialHeight:   1,
+		LastBlockHeight: 5,
+		LastBlockID:     cmtypes.BlockID{Hash: cmbytes.HexBytes(headers[4].Hash())},
+		Validators:      headers[4].Validators,
+		NextValidators:  headers[4].Validators,
+		LastValidators:  headers[4].Validators,
+	}))
+	// the sequencer signed all the blocks
+	lastSigned := append(binary.BigEndian.AppendUint64(nil, 5), headers[4].Hash()...)
+	require.NoError(s.SetMetadata(ctx, block.LastSignedHeaderKey, lastSigned))
+	require.NoError(s.Close())
+	genesis := &cmtypes.GenesisDoc{ChainID: headers[0].ChainID()}
+
+	_, err = Rollback(ctx, nodeConfig, genesis, 0, nil)
+	assert.Error(err)
+	_, err = Rollback(ctx, nodeConfig, genesis, 6, nil)
+	assert.Error(err)
+
+	// the store is rolled back before the application, so the state is returned on application failure
+	app := &testAppRollbacker{err: errors.New("not supported")}
+	st, err := Rollback(ctx, nodeConfig, genesis, 1, app)
+	assert.ErrorIs(err, app.err)
+	assert.ErrorIs(err, ErrAppRollback)
+	assert.Equal(uint64(4), app.height)
+	assert.Equal(uint64(4), st.LastBlockHeight)
+
+	app.err = nil
+	st, err = Rollback(ctx, nodeConfig, genesis, 1, app)
+	require.NoError(err)
+	assert.Equal(uint64(3), app.height)
+	assert.Equal(uint64(3), st.LastBlockHeight)
+	assert.EqualValues(headers[2].Hash(), st.LastBlockID.Hash)
+	assert.Equal(headers[3].AppHash, st.AppHash)
+
+	// sign state is kept, unless reset explicitly
+	signed, err := LastSignedHeight(ctx, nodeConfig)
+	require.NoError(err)
+	assert.Equal(uint64(5), signed)
+	require.NoError(ResetSignState(ctx, nodeConfig, "", st.LastBlockHeight))
+	signed, err = LastSignedHeight(ctx, nodeConfig)
+	require.NoError(err)
+	assert.Equal(uint64(3), signed)
+
+	baseKV, err = store.NewDefaultKVStore(nodeConfig.RootDir, nodeConfig.DBPath, "rollkit")
+	require.NoError(err)
+	s = store.New(newPrefixKV(baseKV, mainPrefix))
+	defer func() {
+		require.NoError(s.Close())
+	}()
+	stored, err := s.GetState(ctx)
+	require.NoError(err)
+	assert.Equal(uint64(3), stored.LastBlockHeight)
+	_, _, err = s.GetBlockData(ctx, 3)
+	assert.NoError(err)
+	_, _, err = s.GetBlockData(ctx, 4)
+	assert.ErrorIs(err, ds.ErrNotFound)
+}
@@ -41,6 +41,12 @@ const (
 
 	// txTopicSuffix is added after namespace to create pubsub topic for TX gossiping.
 	txTopicSuffix = "-tx"
+
+	// evidenceTopicSuffix is added after namespace to create pubsub topic for evidence gossiping.
+	evidenceTopicSuffix = "-evidence"
+
+	// fraudProofTopicSuffix is added after namespace to create pubsub topic for fraud proof gossiping.
+	fraudProofTopicSuffix = "-fraud-proof"
 )
 
 // Client is a P2P client, implemented with libp2p.
@@ -62,6 +68,12 @@ type Client struct {
 	txGossiper  *Gossiper
 	txValidator GossipValidator
 
+	evidenceGossiper  *Gossiper
+	evidenceValidator GossipValidator
+
+	fraudProofGossiper  *Gossiper
+	fraudProofValidator GossipValidator
+
 	// cancel is used to cancel context passed to libp2p functions
 	// it's required because of discovery.Advertise call
 	cancel context.CancelFunc
@@ -156,6 +168,8 @@ func (c *Client) Close() error {
 
 	return errors.Join(
 		c.txGossiper.Close(),
+		c.evidenceGossiper.Close(),
+		c.fraudProofGossiper.Close(),
 		c.dht.Close(),
 		c.host.Close(),
 	)
@@ -172,6 +186,28 @@ func (c *Client) SetTxValidator(val GossipValidator) {
 	c.txValidator = val
 }
 
+// GossipEvidence sends the evidence to the P2P network.
+func (c *Client) GossipEvidence(ctx context.Context, evidence []byte) error {
+	c.logger.Debug("Gossiping evidence", "len", len(evidence))
+	return c.evidenceGossiper.Publish(ctx, evidence)
+}
+
+// SetEvidenceValidator sets the callback function, that will be invoked during evidence gossiping.
+func (c *Client) SetEvidenceValidator(val GossipValidator) {
+	c.evidenceValidator = val
+}
+
+// GossipFraudProof sends the fraud proof to the P2P network.
+func (c *Client) GossipFraudProof(ctx context.Context, proof []byte) error {
+	c.logger.Debug("Gossiping fraud proof", "len", len(proof))
+	return c.fraudProofGossiper.Publish(ctx, proof)
+}
+
+// SetFraudProofValidator sets the callback function, that will be invoked during fraud proof gossiping.
+func (c *Client) SetFraudProofValidator(val GossipValidator) {
+	c.fraudProofValidator = val
+}
+
 // Addrs returns listen addresses of Client.
 func (c *Client) Addrs() []multiaddr.Multiaddr {
 	return c.host.Addrs()
@@ -365,6 +401,18 @@ func (c *Client) setupGossiping(ctx context.Context) error {
 	}
 	go c.txGossiper.ProcessMessages(ctx)
 
+	c.evidenceGossiper, err = NewGossiper(c.host, c.ps, c.getEvidenceTopic(), c.logger, WithValidator(c.evidenceValidator))
+	if err != nil {
+		return err
+	}
+	go c.evidenceGossiper.ProcessMessages(ctx)
+
+	c.fraudProofGossiper, err = NewGossiper(c.host, c.ps, c.getFraudProofTopic(), c.logger, WithValidator(c.fraudProofValidator))
+	if err != nil {
+		return err
+	}
+	go c.fraudProofGossiper.ProcessMessages(ctx)
+
 	return nil
 }
 
@@ -402,3 +450,11 @@ func (c *Client) getNamespace() string {
 func (c *Client) getTxTopic() string {
 	return c.getNamespace() + txTopicSuffix
 }
+
+func (c *Client) getEvidenceTopic() string {
+	return c.getNamespace() + evidenceTopicSuffix
+}
+
+func (c *Client) getFraudProofTopic() string {
+	return c.getNamespace() + fraudProofTopicSuffix
+}
@@ -0,0 +1,294 @@
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	rollconf "github.com/rollkit/rollkit/config"
+)
+
+var (
+	// ErrMethodNotAllowed is returned when the called method is denied by configuration.
+	ErrMethodNotAllowed = errors.New("method not allowed")
+	// ErrUnauthorized is returned when the called method requires authentication, or the token is invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited is returned when the client exceeded configured rate limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// Reasons of call rejection, reported in metrics.
+const (
+	reasonMethodNotAllowed = "method_not_allowed"
+	reasonUnauthorized     = "unauthorized"
+	reasonInvalidToken     = "invalid_token"
+	reasonRateLimited      = "rate_limited"
+)
+
+// AccessControl authenticates clients, limits the rate of calls and restricts methods that can be called.
+// Authorize is used as JSON-RPC handler middleware (see json.WithAuthorizer), so every call (including
+// URI requests, batched calls and calls over WebSocket) is checked. Calls of the gRPC API are checked by
+// interceptors (see GRPCServerOptions), as calls of equivalent JSON-RPC methods.
+type AccessControl struct {
+	conf    rollconf.RPCConfig
+	metrics *Metrics
+
+	ipLimiter    *rateLimiter
+	tokenLimiter *rateLimiter
+	now          func() time.Time
+}
+
+// NewAccessControl creates AccessControl configured with conf. It returns an error if any of method patterns is malformed.
+func NewAccessControl(conf rollconf.RPCConfig, metrics *Metrics) (*AccessControl, error) {
+	for _, patterns := range [][]string{conf.ProtectedMethods, conf.AllowedMethods, conf.DeniedMethods} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid method pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	ac := &AccessControl{
+		conf:    conf,
+		metrics: metrics,
+		now:     time.Now,
+	}
+	if conf.RateLimit > 0 {
+		ac.ipLimiter = newRateLimiter(conf.RateLimit, conf.RateLimitBurst)
+	}
+	if conf.TokenRateLimit > 0 {
+		ac.tokenLimiter = newRateLimiter(conf.TokenRateLimit, conf.TokenRateLimitBurst)
+	}
+	return ac, nil
+}
+
+// Authorize checks if method can be called in context of the HTTP request r.
+func (ac *AccessControl) Authorize(r *http.Request, method string) error {
+	return ac.authorize(method, r.Header.Get("Authorization"), clientIP(r))
+}
+
+// GRPCServerOptions returns gRPC server options checking every call of the gRPC API, like a call of
+// the equivalent JSON-RPC method (see grpcMethods). Bearer token is taken from "authorization" metadata.
+func (ac *AccessControl) GRPCServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := ac.authorizeGRPC(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := ac.authorizeGRPC(ss.Context(), info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	}
+}
+
+// grpcMethods maps methods of the gRPC API to equivalent JSON-RPC methods, so the same method patterns
+// apply to both APIs. Methods not listed here are checked using their full gRPC name.
+var grpcMethods = map[string]string{
+	"/rollkit.RollkitService/GetBlock":        "block",
+	"/rollkit.RollkitService/GetBlockRange":   "blockchain",
+	"/rollkit.RollkitService/GetState":        "status",
+	"/rollkit.RollkitService/GetDAStatus":     "rollkit_da_status",
+	"/rollkit.RollkitService/BroadcastTx":     "broadcast_tx_sync",
+	"/rollkit.RollkitService/SubscribeBlocks": "subscribe",
+}
+
+func (ac *AccessControl) authorizeGRPC(ctx context.Context, fullMethod string) error {
+	method, ok := grpcMethods[fullMethod]
+	if !ok {
+		method = fullMethod
+	}
+	var authorization, ip string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+
+	err := ac.authorize(method, authorization, ip)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrMethodNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// authorize checks if method can be called by the client with given Authorization header and IP address.
+func (ac *AccessControl) authorize(method, authorization, ip string) error {
+	if !ac.methodAllowed(method) {
+		return ac.reject(method, reasonMethodNotAllowed, fmt.Errorf("%w: %s", ErrMethodNotAllowed, method))
+	}
+
+	client, err := ac.authenticate(authorization)
+	if err != nil {
+		return ac.reject(method, reasonInvalidToken, err)
+	}
+	if client == "" && ac.authEnabled() && matchAny(ac.conf.ProtectedMethods, method) {
+		return ac.reject(method, reasonUnauthorized, fmt.Errorf("%w: %s requires authentication", ErrUnauthorized, method))
+	}
+
+	// authenticated clients are limited per token, others per IP address
+	limiter, key := ac.ipLimiter, ip
+	if client != "" {
+		limiter, key = ac.tokenLimiter, client
+	}
+	if limiter != nil && !limiter.allow(key) {
+		return ac.reject(method, reasonRateLimited, ErrRateLimited)
+	}
+	return nil
+}
+
+func (ac *AccessControl) methodAllowed(method string) bool {
+	if matchAny(ac.conf.DeniedMethods, method) {
+		return false
+	}
+	return len(ac.conf.AllowedMethods) == 0 || matchAny(ac.conf.AllowedMethods, method)
+}
+
+func (ac *AccessControl) authEnabled() bool {
+	return len(ac.conf.AuthTokens) > 0 || ac.conf.JWTSecret != ""
+}
+
+// authenticate verifies bearer token from Authorization header. It returns identifier of authenticated client,
+// or empty string if the header is empty.
+func (ac *AccessControl) authenticate(header string) (string, error) {
+	if header == "" || !ac.authEnabled() {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", fmt.Errorf("%w: expecting bearer token", ErrUnauthorized)
+	}
+
+	for _, t := range ac.conf.AuthTokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return token, nil
+		}
+	}
+	if ac.conf.JWTSecret != "" {
+		subject, err := verifyJWT(token, []byte(ac.conf.JWTSecret), ac.now())
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrUnauthorized, err)
+		}
+		if subject != "" {
+			return "jwt:" + subject, nil
+		}
+		return token, nil
+	}
+	return "", fmt.Errorf("%w: invalid token", ErrUnauthorized)
+}
+
+func (ac *AccessControl) reject(method, reason string, err error) error {
+	ac.metrics.RejectedCalls.With("reason", reason, "method", method).Add(1)
+	return err
+}
+
+// verifyJWT verifies signature and validity period of HS256 signed JWT. Tokens have to expire, so the
+// expiration time is required. It returns the subject of the token.
+func verifyJWT(token string, secret []byte, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed JWT")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return "", err
+	}
+	if header.Alg != "HS256" {
+		return "", fmt.Errorf("unsupported JWT algorithm %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed JWT signature: %w", err)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", errors.New("invalid JWT signature")
+	}
+
+	var claims struct {
+		Subject   string   `json:"sub"`
+		ExpiresAt *float64 `json:"exp"`
+		NotBefore *float64 `json:"nbf"`
+	}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", err
+	}
+	unix := float64(now.Unix())
+	if claims.ExpiresAt == nil {
+		return "", errors.New("JWT has no expiration time")
+	}
+	if unix >= *claims.ExpiresAt {
+		return "", errors.New("JWT expired")
+	}
+	if claims.NotBefore != nil && unix < *claims.NotBefore {
+		return "", errors.New("JWT not valid yet")
+	}
+	return claims.Subject, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return fmt.Errorf("malformed JWT: %w", err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("malformed JWT: %w", err)
+	}
+	return nil
+}
+
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		// patterns are validated in NewAccessControl
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
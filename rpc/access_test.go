@@ -0,0 +1,186 @@
+package rpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	rollconf "github.com/rollkit/rollkit/config"
+)
+
+func newRequest(remoteAddr, token string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.RemoteAddr = remoteAddr
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func signJWT(secret, claims string) string {
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+func TestAccessControlMethods(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ac, err := NewAccessControl(rollconf.RPCConfig{
+		AllowedMethods: []string{"block*", "status", "broadcast_tx_*"},
+		DeniedMethods:  []string{"block_search"},
+	}, NopMetrics())
+	require.NoError(err)
+
+	r := newRequest("10.0.0.1:1234", "")
+	assert.NoError(ac.Authorize(r, "status"))
+	assert.NoError(ac.Authorize(r, "block_results"))
+	assert.ErrorIs(ac.Authorize(r, "block_search"), ErrMethodNotAllowed)
+	assert.ErrorIs(ac.Authorize(r, "tx_search"), ErrMethodNotAllowed)
+	// authentication is not configured, so protected methods are available
+	assert.NoError(ac.Authorize(r, "broadcast_tx_sync"))
+
+	_, err = NewAccessControl(rollconf.RPCConfig{DeniedMethods: []string{"["}}, NopMetrics())
+	assert.Error(err)
+}
+
+func TestAccessControlAuth(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	const secret = "secret"
+	ac, err := NewAccessControl(rollconf.RPCConfig{
+		AuthTokens:       []string{"token"},
+		JWTSecret:        secret,
+		ProtectedMethods: rollconf.DefaultNodeConfig.RPC.ProtectedMethods,
+	}, NopMetrics())
+	require.NoError(err)
+	now := time.Unix(1000, 0)
+	ac.now = func() time.Time { return now }
+
+	const addr = "10.0.0.1:1234"
+	assert.NoError(ac.Authorize(newRequest(addr, ""), "status"))
+	assert.ErrorIs(ac.Authorize(newRequest(addr, ""), "broadcast_tx_sync"), ErrUnauthorized)
+	assert.NoError(ac.Authorize(newRequest(addr, "token"), "broadcast_tx_sync"))
+	assert.ErrorIs(ac.Authorize(newRequest(addr, "wrong"), "broadcast_tx_sync"), ErrUnauthorized)
+	// invalid token is rejected even for unprotected methods
+	assert.ErrorIs(ac.Authorize(newRequest(addr, "wrong"), "status"), ErrUnauthorized)
+
+	basic := newRequest(addr, "")
+	basic.SetBasicAuth("user", "token")
+	assert.ErrorIs(ac.Authorize(basic, "status"), ErrUnauthorized)
+
+	cases := []struct {
+		name   string
+		token  string
+		hasErr bool
+	}{
+		{"valid", signJWT(secret, `{"sub":"alice","exp":2000}`), false},
+		{"no expiration", signJWT(secret, `{"sub":"alice"}`), true},
+		{"expired", signJWT(secret, `{"sub":"alice","exp":1000}`), true},
+		{"not valid yet", signJWT(secret, `{"sub":"alice","exp":2000,"nbf":1001}`), true},
+		{"wrong secret", signJWT("other", `{"sub":"alice","exp":2000}`), true},
+		{"malformed", "a.b.c", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ac.Authorize(newRequest(addr, c.token), "broadcast_tx_async")
+			if c.hasErr {
+				assert.ErrorIs(err, ErrUnauthorized)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestAccessControlGRPC(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ac, err := NewAccessControl(rollconf.RPCConfig{
+		AuthTokens:       []string{"token"},
+		ProtectedMethods: rollconf.DefaultNodeConfig.RPC.ProtectedMethods,
+		DeniedMethods:    []string{"blockchain"},
+		RateLimit:        1,
+		RateLimitBurst:   1,
+	}, NopMetrics())
+	require.NoError(err)
+
+	call := func(addr, token, method string) codes.Code {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 1234}})
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+		}
+		return status.Code(ac.authorizeGRPC(ctx, method))
+	}
+
+	assert.Equal(codes.Unauthenticated, call("10.0.0.1", "", "/rollkit.RollkitService/BroadcastTx"))
+	assert.Equal(codes.OK, call("10.0.0.1", "token", "/rollkit.RollkitService/BroadcastTx"))
+	assert.Equal(codes.PermissionDenied, call("10.0.0.2", "token", "/rollkit.RollkitService/GetBlockRange"))
+	assert.Equal(codes.OK, call("10.0.0.3", "", "/rollkit.RollkitService/GetBlock"))
+	assert.Equal(codes.ResourceExhausted, call("10.0.0.3", "", "/rollkit.RollkitService/GetBlock"))
+}
+
+func TestAccessControlRateLimit(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ac, err := NewAccessControl(rollconf.RPCConfig{
+		AuthTokens:          []string{"token"},
+		RateLimit:           1,
+		RateLimitBurst:      2,
+		TokenRateLimit:      1,
+		TokenRateLimitBurst: 3,
+	}, NopMetrics())
+	require.NoError(err)
+	now := time.Unix(1000, 0)
+	ac.ipLimiter.now = func() time.Time { return now }
+	ac.tokenLimiter.now = func() time.Time { return now }
+
+	client1 := newRequest("10.0.0.1:1234", "")
+	client2 := newRequest("10.0.0.2:1234", "")
+	authenticated := newRequest("10.0.0.1:1234", "token")
+
+	assert.NoError(ac.Authorize(client1, "status"))
+	assert.NoError(ac.Authorize(client1, "status"))
+	assert.ErrorIs(ac.Authorize(client1, "status"), ErrRateLimited)
+	assert.NoError(ac.Authorize(client2, "status"))
+
+	// authenticated client is limited per token, not per IP address
+	for i := 0; i < 3; i++ {
+		assert.NoError(ac.Authorize(authenticated, "status"))
+	}
+	assert.ErrorIs(ac.Authorize(authenticated, "status"), ErrRateLimited)
+
+	now = now.Add(time.Second)
+	assert.NoError(ac.Authorize(client1, "status"))
+	assert.ErrorIs(ac.Authorize(client1, "status"), ErrRateLimited)
+}
+
+func TestRateLimiterCleanup(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+
+	assert.True(t, l.allow("a"))
+	now = now.Add(rateLimiterCleanupInterval)
+	assert.True(t, l.allow("b"))
+	assert.Len(t, l.buckets, 1)
+}
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtypes "github.com/cometbft/cometbft/types"
+	ds "github.com/ipfs/go-datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/rollkit/block"
+	"github.com/rollkit/rollkit/store"
+	"github.com/rollkit/rollkit/types"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// subscriptionBufferSize is the capacity of block subscription channel.
+const subscriptionBufferSize = 100
+
+// MaxBlockRange is the maximum number of blocks streamed by a single GetBlockRange call.
+const MaxBlockRange = 100
+
+// Node is the node functionality exposed by the gRPC service.
+type Node interface {
+	GetClient() rpcclient.Client
+	GetStore() store.Store
+	GetBlockManager() *block.Manager
+}
+
+var _ pb.RollkitServiceServer = &Service{}
+
+// Service implements RollkitService, serving Rollkit types directly from the node.
+type Service struct {
+	node   Node
+	logger log.Logger
+
+	// subscriptions is used to generate unique subscriber IDs
+	subscriptions atomic.Uint64
+}
+
+// NewService creates new instance of Service.
+func NewService(node Node, logger log.Logger) *Service {
+	return &Service{
+		node:   node,
+		logger: logger,
+	}
+}
+
+// GetBlock returns the block at given height or with given hash.
+func (s *Service) GetBlock(ctx context.Context, req *pb.GetBlockRequest) (*pb.BlockResponse, error) {
+	var (
+		header *types.SignedHeader
+		data   *types.Data
+		err    error
+	)
+	switch {
+	case len(req.Hash) > 0:
+		header, data, err = s.node.GetStore().GetBlockByHash(ctx, req.Hash)
+	case req.Height == 0:
+		header, data, err = s.node.GetStore().GetBlockData(ctx, s.node.GetStore().Height())
+	default:
+		header, data, err = s.node.GetStore().GetBlockData(ctx, req.Height)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return s.blockResponse(header, data)
+}
+
+// GetBlockRange streams blocks from the given height range, of at most MaxBlockRange blocks.
+func (s *Service) GetBlockRange(req *pb.GetBlockRangeRequest, stream pb.RollkitService_GetBlockRangeServer) error {
+	if req.FromHeight == 0 || req.FromHeight > req.ToHeight {
+		return status.Errorf(codes.InvalidArgument, "invalid block range: [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	if req.ToHeight-req.FromHeight >= MaxBlockRange {
+		return status.Errorf(codes.InvalidArgument, "block range [%d, %d] exceeds %d blocks", req.FromHeight, req.ToHeight, MaxBlockRange)
+	}
+	if height := s.node.GetStore().Height(); req.ToHeight > height {
+		return status.Errorf(codes.OutOfRange, "to_height %d is greater than current height %d", req.ToHeight, height)
+	}
+	ctx := stream.Context()
+	for height := req.FromHeight; height <= req.ToHeight; height++ {
+		if err := s.sendBlock(ctx, stream, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetState returns the latest state of the node.
+func (s *Service) GetState(ctx context.Context, _ *pb.GetStateRequest) (*pb.GetStateResponse, error) {
+	state, err := s.node.GetStore().GetState(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	pbState, err := state.ToProto()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetStateResponse{State: pbState}, nil
+}
+
+// GetDAStatus returns the status of the DA layer synchronization.
+func (s *Service) GetDAStatus(_ context.Context, _ *pb.GetDAStatusRequest) (*pb.GetDAStatusResponse, error) {
+	manager := s.node.GetBlockManager()
+	return &pb.GetDAStatusResponse{
+		DaHeight:         manager.GetDAHeight(),
+		DaIncludedHeight: manager.GetDAIncludedHeight(),
+		Height:           s.node.GetStore().Height(),
+	}, nil
+}
+
+// BroadcastTx submits the transaction to the mempool and returns the result of CheckTx.
+func (s *Service) BroadcastTx(ctx context.Context, req *pb.BroadcastTxRequest) (*pb.BroadcastTxResponse, error) {
+	if len(req.Tx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty transaction")
+	}
+	res, err := s.node.GetClient().BroadcastTxSync(ctx, req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &pb.BroadcastTxResponse{
+		Code:      res.Code,
+		Data:      res.Data,
+		Log:       res.Log,
+		Codespace: res.Codespace,
+		Hash:      res.Hash,
+	}, nil
+}
+
+// SubscribeBlocks streams blocks as they are committed by the node.
+func (s *Service) SubscribeBlocks(_ *pb.SubscribeBlocksRequest, stream pb.RollkitService_SubscribeBlocksServer) error {
+	ctx := stream.Context()
+	client := s.node.GetClient()
+	subscriber := fmt.Sprintf("grpc-%d", s.subscriptions.Add(1))
+	query := cmtypes.EventQueryNewBlock.String()
+	events, err := client.Subscribe(ctx, subscriber, query, subscriptionBufferSize)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer func() {
+		if err := client.Unsubscribe(context.Background(), subscriber, query); err != nil {
+			s.logger.Error("failed to unsubscribe", "subscriber", subscriber, "error", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Aborted, "subscription was cancelled")
+			}
+			newBlock, ok := event.Data.(cmtypes.EventDataNewBlock)
+			if !ok || newBlock.Block == nil {
+				continue
+			}
+			if err := s.sendBlock(ctx, stream, uint64(newBlock.Block.Height)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// blockStream is implemented by server streams of BlockResponse.
+type blockStream interface {
+	Send(*pb.BlockResponse) error
+}
+
+func (s *Service) sendBlock(ctx context.Context, stream blockStream, height uint64) error {
+	header, data, err := s.node.GetStore().GetBlockData(ctx, height)
+	if err != nil {
+		return toStatusError(err)
+	}
+	resp, err := s.blockResponse(header, data)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
+func (s *Service) blockResponse(header *types.SignedHeader, data *types.Data) (*pb.BlockResponse, error) {
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	manager := s.node.GetBlockManager()
+	return &pb.BlockResponse{
+		Header:     pbHeader,
+		Data:       data.ToProto(),
+		DaIncluded: header.Height() <= manager.GetDAIncludedHeight() || manager.IsDAIncluded(header.Hash()),
+	}, nil
+}
+
+// toStatusError maps store errors to gRPC status errors.
+func toStatusError(err error) error {
+	if errors.Is(err, ds.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,224 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmconfig "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/rollkit/config"
+	"github.com/rollkit/rollkit/node"
+	"github.com/rollkit/rollkit/signer"
+	"github.com/rollkit/rollkit/test/mocks"
+	testServer "github.com/rollkit/rollkit/test/server"
+	"github.com/rollkit/rollkit/types"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+const (
+	// MockDAAddress is the mock address for the gRPC server
+	MockDAAddress = "grpc://localhost:7982"
+
+	// MockDANamespace is the mock namespace
+	MockDANamespace = "00000000000000000000000000000000000000000000000000deadbeef"
+)
+
+// TestMain starts the mock gRPC server
+// gRPC service listens on MockDAAddress
+func TestMain(m *testing.M) {
+	grpcSrv := testServer.StartMockDAServGRPC(MockDAAddress)
+
+	exitCode := m.Run()
+
+	// teardown servers
+	// nolint:errcheck,gosec
+	grpcSrv.Stop()
+
+	os.Exit(exitCode)
+}
+
+func TestService(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	n := getNode(t)
+	client := getClient(t, n)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.Eventually(func() bool {
+		return n.GetStore().Height() >= 3
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// latest block
+	resp, err := client.GetBlock(ctx, &pb.GetBlockRequest{})
+	require.NoError(err)
+	require.NotNil(resp.Header)
+	require.NotNil(resp.Data)
+	assert.GreaterOrEqual(resp.Header.Header.Height, uint64(3))
+
+	resp, err = client.GetBlock(ctx, &pb.GetBlockRequest{Height: 2})
+	require.NoError(err)
+	assert.Equal(uint64(2), resp.Header.Header.Height)
+	var header types.SignedHeader
+	require.NoError(header.FromProto(resp.Header))
+
+	resp, err = client.GetBlock(ctx, &pb.GetBlockRequest{Hash: header.Hash()})
+	require.NoError(err)
+	assert.Equal(uint64(2), resp.Header.Header.Height)
+
+	_, err = client.GetBlock(ctx, &pb.GetBlockRequest{Height: 1000})
+	assert.Equal(codes.NotFound, status.Code(err))
+
+	// block range
+	stream, err := client.GetBlockRange(ctx, &pb.GetBlockRangeRequest{FromHeight: 1, ToHeight: 3})
+	require.NoError(err)
+	for height := uint64(1); height <= 3; height++ {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		assert.Equal(height, resp.Header.Header.Height)
+		assert.Equal(height, resp.Data.Metadata.Height)
+	}
+	_, err = stream.Recv()
+	assert.ErrorIs(err, io.EOF)
+
+	stream, err = client.GetBlockRange(ctx, &pb.GetBlockRangeRequest{FromHeight: 3, ToHeight: 1})
+	require.NoError(err)
+	_, err = stream.Recv()
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	stream, err = client.GetBlockRange(ctx, &pb.GetBlockRangeRequest{FromHeight: 1, ToHeight: MaxBlockRange + 1})
+	require.NoError(err)
+	_, err = stream.Recv()
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// state and DA status
+	state, err := client.GetState(ctx, &pb.GetStateRequest{})
+	require.NoError(err)
+	assert.Equal("test", state.State.ChainId)
+	assert.GreaterOrEqual(state.State.LastBlockHeight, uint64(3))
+
+	daStatus, err := client.GetDAStatus(ctx, &pb.GetDAStatusRequest{})
+	require.NoError(err)
+	assert.GreaterOrEqual(daStatus.Height, uint64(3))
+
+	// transactions
+	_, err = client.BroadcastTx(ctx, &pb.BroadcastTxRequest{})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	tx := cmtypes.Tx("grpc tx")
+	txResp, err := client.BroadcastTx(ctx, &pb.BroadcastTxRequest{Tx: tx})
+	require.NoError(err)
+	assert.Equal(abci.CodeTypeOK, txResp.Code)
+	assert.Equal(tx.Hash(), txResp.Hash)
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	n := getNode(t)
+	client := getClient(t, n)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.SubscribeBlocks(ctx, &pb.SubscribeBlocksRequest{})
+	require.NoError(err)
+
+	first, err := stream.Recv()
+	require.NoError(err)
+	second, err := stream.Recv()
+	require.NoError(err)
+	assert.Equal(first.Header.Header.Height+1, second.Header.Header.Height)
+	assert.NotEmpty(second.Header.Signature)
+}
+
+// getNode starts aggregator full node.
+func getNode(t *testing.T) *node.FullNode {
+	t.Helper()
+	require := require.New(t)
+
+	app := &mocks.Application{}
+	app.On("InitChain", mock.Anything, mock.Anything).Return(&abci.ResponseInitChain{}, nil)
+	app.On("PrepareProposal", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		})
+	app.On("ProcessProposal", mock.Anything, mock.Anything).Return(&abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil)
+	app.On("FinalizeBlock", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+			txResults := make([]*abci.ExecTxResult, len(req.Txs))
+			for i := range txResults {
+				txResults[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+			}
+			return &abci.ResponseFinalizeBlock{TxResults: txResults}, nil
+		})
+	app.On("Commit", mock.Anything, mock.Anything).Return(&abci.ResponseCommit{}, nil)
+	app.On("CheckTx", mock.Anything, mock.Anything).Return(&abci.ResponseCheckTx{Code: abci.CodeTypeOK}, nil)
+	app.On("Info", mock.Anything, mock.Anything).Return(&abci.ResponseInfo{}, nil)
+
+	key, _, _ := crypto.GenerateEd25519Key(rand.Reader)
+	validatorKey := ed25519.GenPrivKey()
+	signingKey, err := signer.NewLocalSigner(validatorKey, "")
+	require.NoError(err)
+	pubKey := validatorKey.PubKey()
+	genesis := &cmtypes.GenesisDoc{
+		ChainID:    "test",
+		Validators: []cmtypes.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: int64(100), Name: "gen #1"}},
+	}
+	conf := config.NodeConfig{
+		DAAddress:          MockDAAddress,
+		DANamespace:        MockDANamespace,
+		Aggregator:         true,
+		BlockManagerConfig: config.BlockManagerConfig{BlockTime: 100 * time.Millisecond},
+	}
+	n, err := node.NewNode(context.Background(), conf, key, signingKey, proxy.NewLocalClientCreator(app), genesis, node.DefaultMetricsProvider(cmconfig.DefaultInstrumentationConfig()), log.TestingLogger())
+	require.NoError(err)
+	require.NoError(n.Start())
+	t.Cleanup(func() {
+		_ = n.Stop()
+	})
+
+	fullNode, ok := n.(*node.FullNode)
+	require.True(ok)
+	return fullNode
+}
+
+// getClient serves Service on random port and returns a client connected to it.
+func getClient(t *testing.T, n Node) pb.RollkitServiceClient {
+	t.Helper()
+	require := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	srv := grpc.NewServer()
+	pb.RegisterRollkitServiceServer(srv, NewService(n, log.TestingLogger()))
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return pb.NewRollkitServiceClient(conn)
+}
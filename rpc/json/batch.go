@@ -0,0 +1,105 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/rpc/v2/json2"
+)
+
+// serveJSONRPCforWS serves HTTP request, containing a single JSON-RPC request or a batch of requests.
+func (h *handler) serveJSONRPCforWS(w http.ResponseWriter, r *http.Request, wsConn *wsConn) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.writeRequestError(w, &json2.Error{Code: json2.E_INVALID_REQ, Message: err.Error()})
+		return
+	}
+	if !isBatch(body) {
+		h.serveRequest(w, withBody(r, body), wsConn)
+		return
+	}
+
+	requests, err := h.splitBatch(body)
+	if err != nil {
+		h.writeRequestError(w, err)
+		return
+	}
+	responses := make([]json.RawMessage, 0, len(requests))
+	for _, req := range requests {
+		buf := new(bytes.Buffer)
+		h.serveRequest(newResponseWriter(buf), withBody(r, req), wsConn)
+		if resp := bytes.TrimSpace(buf.Bytes()); len(resp) > 0 {
+			responses = append(responses, resp)
+		}
+	}
+	// batch containing only notifications has no response
+	if len(responses) == 0 {
+		return
+	}
+
+	w.Header().Set("x-content-type-options", "nosniff")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(responses); err != nil {
+		h.logger.Error("failed to encode RPC batch response", "error", err)
+	}
+}
+
+// splitBatch splits JSON-RPC batch request into separate requests.
+func (h *handler) splitBatch(body []byte) ([]json.RawMessage, error) {
+	var requests []json.RawMessage
+	if err := json.Unmarshal(body, &requests); err != nil {
+		return nil, &json2.Error{Code: json2.E_PARSE, Message: err.Error()}
+	}
+	if len(requests) == 0 {
+		return nil, &json2.Error{Code: json2.E_INVALID_REQ, Message: "empty batch request"}
+	}
+	if maxSize := h.srv.config.MaxRequestBatchSize; maxSize > 0 && len(requests) > maxSize {
+		return nil, &json2.Error{
+			Code:    json2.E_INVALID_REQ,
+			Message: fmt.Sprintf("batch request size %d exceeds max_request_batch_size %d", len(requests), maxSize),
+		}
+	}
+	return requests, nil
+}
+
+// writeRequestError writes error response for request that couldn't be split into JSON-RPC calls.
+// As request id is unknown, id of the response is null.
+func (h *handler) writeRequestError(w http.ResponseWriter, err error) {
+	w.Header().Set("x-content-type-options", "nosniff")
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	resp := response{
+		Version: "2.0",
+		Error:   toJSONRPCError(err),
+		ID:      json.RawMessage("null"),
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("failed to encode RPC response", "error", err)
+	}
+}
+
+// toJSONRPCError returns err as JSON-RPC error, wrapping errors of other types in server error.
+func toJSONRPCError(err error) *json2.Error {
+	var e *json2.Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return &json2.Error{Code: json2.E_SERVER, Message: err.Error()}
+}
+
+// isBatch checks if request body contains JSON-RPC batch (JSON array).
+func isBatch(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// withBody returns a copy of r with body replaced by given bytes.
+func withBody(r *http.Request, body []byte) *http.Request {
+	req := r.Clone(r.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return req
+}
@@ -0,0 +1,134 @@
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/gorilla/rpc/v2/json2"
+
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// serveBinary serves JSON-RPC request (or batch of requests) received in binary WebSocket frame.
+// Response is protobuf encoded RPCResponse (or RPCBatchResponse for batch requests). Results of block,
+// block_by_hash and block_results are protobuf encoded, results of other methods are JSON encoded.
+// It returns nil if there is no response to send (notifications).
+func (h *handler) serveBinary(r *http.Request, wsConn *wsConn) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if !isBatch(body) {
+		resp := h.serveBinaryRequest(r, body, wsConn)
+		if resp == nil {
+			return nil, nil
+		}
+		return resp.Marshal()
+	}
+
+	batch := &pb.RPCBatchResponse{}
+	requests, err := h.splitBatch(body)
+	if err != nil {
+		batch.Responses = append(batch.Responses, &pb.RPCResponse{Id: []byte("null"), Error: toRPCError(err)})
+	}
+	for _, req := range requests {
+		if resp := h.serveBinaryRequest(r, req, wsConn); resp != nil {
+			batch.Responses = append(batch.Responses, resp)
+		}
+	}
+	if len(batch.Responses) == 0 {
+		return nil, nil
+	}
+	return batch.Marshal()
+}
+
+// serveBinaryRequest serves a single JSON-RPC request. It returns nil for notifications and empty requests.
+func (h *handler) serveBinaryRequest(r *http.Request, body []byte, wsConn *wsConn) *pb.RPCResponse {
+	// errors are reported by codec
+	var req struct {
+		ID *json.RawMessage `json:"id"`
+	}
+	_ = json.Unmarshal(body, &req)
+
+	res := h.call(h.codec.NewRequest(withBody(r, body)), r, wsConn)
+	if res == nil {
+		return nil
+	}
+	// notifications don't have a response, unless request couldn't be parsed
+	if req.ID == nil && (res.err == nil || toJSONRPCError(res.err).Code != json2.E_PARSE) {
+		return nil
+	}
+
+	resp := &pb.RPCResponse{Id: []byte("null")}
+	if req.ID != nil {
+		resp.Id = *req.ID
+	}
+	if res.err != nil {
+		resp.Error = toRPCError(res.err)
+		return resp
+	}
+	if err := setBinaryResult(resp, res.result); err != nil {
+		resp.Error = toRPCError(err)
+	}
+	return resp
+}
+
+// setBinaryResult encodes result of RPC method call in response.
+func setBinaryResult(resp *pb.RPCResponse, result interface{}) error {
+	switch res := result.(type) {
+	case *ctypes.ResultBlock:
+		var block *cmproto.Block
+		if res.Block != nil {
+			var err error
+			if block, err = res.Block.ToProto(); err != nil {
+				return err
+			}
+		}
+		blockID := res.BlockID.ToProto()
+		resp.Block = &pb.RPCResultBlock{BlockId: &blockID, Block: block}
+	case *ctypes.ResultBlockResults:
+		resp.BlockResults = &pb.RPCResultBlockResults{
+			Height:                res.Height,
+			TxsResults:            res.TxsResults,
+			FinalizeBlockEvents:   toPointers(res.FinalizeBlockEvents),
+			ValidatorUpdates:      toPointers(res.ValidatorUpdates),
+			ConsensusParamUpdates: res.ConsensusParamUpdates,
+			AppHash:               res.AppHash,
+		}
+	default:
+		raw, err := cmjson.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.JsonResult = raw
+	}
+	return nil
+}
+
+// toRPCError converts err to protobuf encoded JSON-RPC error.
+func toRPCError(err error) *pb.RPCError {
+	e := toJSONRPCError(err)
+	rpcErr := &pb.RPCError{Code: int32(e.Code), Message: e.Message}
+	if e.Data != nil {
+		if data, err := json.Marshal(e.Data); err == nil {
+			rpcErr.Data = string(data)
+		}
+	}
+	return rpcErr
+}
+
+func toPointers[T abci.Event | abci.ValidatorUpdate](values []T) []*T {
+	if values == nil {
+		return nil
+	}
+	ptrs := make([]*T, len(values))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	return ptrs
+}
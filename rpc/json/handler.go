@@ -37,9 +37,12 @@ func newHandler(s *service, codec rpc.Codec, logger log.Logger) *handler {
 
 	mux.HandleFunc("/", h.serveJSONRPC)
 	mux.HandleFunc("/websocket", h.wsHandler)
+	mux.HandleFunc("/health/sync", h.syncHealth)
+	mux.HandleFunc("/healthz", h.componentHealth(HealthClient.Liveness))
+	mux.HandleFunc("/readyz", h.componentHealth(HealthClient.Readiness))
 	for name, method := range s.methods {
 		logger.Debug("registering method", "name", name)
-		mux.HandleFunc("/"+name, h.newHandler(method))
+		mux.HandleFunc("/"+name, h.newHandler(name, method))
 	}
 
 	return h
@@ -50,14 +53,49 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // serveJSONRPC serves HTTP request
 func (h *handler) serveJSONRPC(w http.ResponseWriter, r *http.Request) {
+	if h.srv.config.MaxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, h.srv.config.MaxBodyBytes)
+	}
 	h.serveJSONRPCforWS(w, r, nil)
 }
 
-// serveJSONRPC serves HTTP request
+// serveRequest serves a single JSON-RPC request
 // implementation is highly inspired by Gorilla RPC v2 (but simplified a lot)
-func (h *handler) serveJSONRPCforWS(w http.ResponseWriter, r *http.Request, wsConn *wsConn) {
+func (h *handler) serveRequest(w http.ResponseWriter, r *http.Request, wsConn *wsConn) {
 	// Create a new codec request.
 	codecReq := h.codec.NewRequest(r)
+	res := h.call(codecReq, r, wsConn)
+	if res == nil {
+		// just serve empty page if request is empty
+		return
+	}
+
+	// Prevents Internet Explorer from MIME-sniffing a response away
+	// from the declared content-type
+	w.Header().Set("x-content-type-options", "nosniff")
+
+	// Encode the response.
+	if res.err != nil {
+		codecReq.WriteError(w, res.statusCode, res.err)
+		return
+	}
+	raw, err := cmjson.Marshal(res.result)
+	if err != nil {
+		codecReq.WriteError(w, http.StatusInternalServerError, err)
+		return
+	}
+	codecReq.WriteResponse(w, json.RawMessage(raw))
+}
+
+// callResult is the outcome of RPC method call.
+type callResult struct {
+	result     interface{}
+	statusCode int
+	err        error
+}
+
+// call decodes the request and calls requested RPC method. It returns nil if the request is empty.
+func (h *handler) call(codecReq rpc.CodecRequest, r *http.Request, wsConn *wsConn) *callResult {
 	if wsConn != nil {
 		wsConn.codecReq = codecReq
 	}
@@ -66,23 +104,27 @@ func (h *handler) serveJSONRPCforWS(w http.ResponseWriter, r *http.Request, wsCo
 	if err != nil {
 		var e *json2.Error
 		if method == "" && errors.As(err, &e) && e.Message == "EOF" {
-			// just serve empty page if request is empty
-			return
+			return nil
 		}
-		codecReq.WriteError(w, http.StatusBadRequest, err)
-		return
+		return &callResult{statusCode: http.StatusBadRequest, err: err}
 	}
 	methodSpec, ok := h.srv.methods[method]
 	if !ok {
-		codecReq.WriteError(w, int(json2.E_NO_METHOD), err)
-		return
+		return &callResult{
+			statusCode: int(json2.E_NO_METHOD),
+			err:        &json2.Error{Code: json2.E_NO_METHOD, Message: "method not found: " + method},
+		}
+	}
+	if h.srv.authorize != nil {
+		if err := h.srv.authorize(r, method); err != nil {
+			return &callResult{statusCode: http.StatusForbidden, err: err}
+		}
 	}
 
 	// Decode the args.
 	args := reflect.New(methodSpec.argsType)
 	if errRead := codecReq.ReadRequest(args.Interface()); errRead != nil {
-		codecReq.WriteError(w, http.StatusBadRequest, errRead)
-		return
+		return &callResult{statusCode: http.StatusBadRequest, err: errRead}
 	}
 
 	callArgs := []reflect.Value{
@@ -95,34 +137,20 @@ func (h *handler) serveJSONRPCforWS(w http.ResponseWriter, r *http.Request, wsCo
 	rets := methodSpec.m.Call(callArgs)
 
 	// Extract the result to error if needed.
-	var errResult error
-	statusCode := http.StatusOK
-	errInter := rets[1].Interface()
-	if errInter != nil {
-		statusCode = http.StatusBadRequest
-		errResult = errInter.(error)
-	}
-
-	// Prevents Internet Explorer from MIME-sniffing a response away
-	// from the declared content-type
-	w.Header().Set("x-content-type-options", "nosniff")
-
-	// Encode the response.
-	if errResult == nil {
-		var raw json.RawMessage
-		raw, err = cmjson.Marshal(rets[0].Interface())
-		if err != nil {
-			codecReq.WriteError(w, http.StatusInternalServerError, err)
-			return
-		}
-		codecReq.WriteResponse(w, raw)
-	} else {
-		codecReq.WriteError(w, statusCode, errResult)
+	if errInter := rets[1].Interface(); errInter != nil {
+		return &callResult{statusCode: http.StatusBadRequest, err: errInter.(error)}
 	}
+	return &callResult{result: rets[0].Interface(), statusCode: http.StatusOK}
 }
 
-func (h *handler) newHandler(methodSpec *method) func(http.ResponseWriter, *http.Request) {
+func (h *handler) newHandler(name string, methodSpec *method) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if h.srv.authorize != nil {
+			if err := h.srv.authorize(r, name); err != nil {
+				h.encodeAndWriteResponse(w, nil, err, int(json2.E_SERVER))
+				return
+			}
+		}
 		args := reflect.New(methodSpec.argsType)
 		values, err := url.ParseQuery(r.URL.RawQuery)
 		if err != nil {
@@ -163,10 +191,15 @@ func (h *handler) newHandler(methodSpec *method) func(http.ResponseWriter, *http
 				return
 			}
 		}
-		rets := methodSpec.m.Call([]reflect.Value{
+		callArgs := []reflect.Value{
 			reflect.ValueOf(r),
 			args,
-		})
+		}
+		// WebSocket-only methods are called without connection
+		if methodSpec.ws {
+			callArgs = append(callArgs, reflect.ValueOf((*wsConn)(nil)))
+		}
+		rets := methodSpec.m.Call(callArgs)
 
 		// Extract the result to error if needed.
 		statusCode := http.StatusOK
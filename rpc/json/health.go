@@ -0,0 +1,55 @@
+package json
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// syncHealth reports sync status of the node. It responds with 503 Service Unavailable when the node is
+// catching up, so it can be used by load balancers to route traffic only to synced nodes.
+func (h *handler) syncHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	ic, ok := h.srv.client.(IntrospectionClient)
+	if !ok {
+		http.Error(w, ErrIntrospectionNotSupported.Error(), http.StatusNotImplemented)
+		return
+	}
+	status, err := ic.SyncStatus(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if status.CatchingUp {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		h.logger.Error("failed to encode sync status", "error", err)
+	}
+}
+
+// componentHealth reports health of node components returned by check. It responds with 503 Service Unavailable
+// when any of the components is unhealthy, so it can be used by Kubernetes liveness and readiness probes.
+func (h *handler) componentHealth(check func(HealthClient, context.Context) (*types.ResultHealth, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		hc, ok := h.srv.client.(HealthClient)
+		if !ok {
+			http.Error(w, ErrHealthNotSupported.Error(), http.StatusNotImplemented)
+			return
+		}
+		res, err := check(hc, r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !res.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			h.logger.Error("failed to encode health status", "error", err)
+		}
+	}
+}
@@ -21,9 +21,9 @@ import (
 
 	"github.com/rollkit/rollkit/config"
 	"github.com/rollkit/rollkit/node"
+	"github.com/rollkit/rollkit/signer"
 	"github.com/rollkit/rollkit/test/mocks"
 	testServer "github.com/rollkit/rollkit/test/server"
-	"github.com/rollkit/rollkit/types"
 )
 
 const (
@@ -97,7 +97,7 @@ func getRPC(t *testing.T) (*mocks.Application, rpcclient.Client) {
 	nodeKey := &p2p.NodeKey{
 		PrivKey: validatorKey,
 	}
-	signingKey, _ := types.GetNodeKey(nodeKey)
+	signingKey, _ := signer.NewLocalSigner(nodeKey.PrivKey, "")
 	pubKey := validatorKey.PubKey()
 
 	genesisValidators := []cmtypes.GenesisValidator{
@@ -0,0 +1,72 @@
+package json
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "rpc"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of open WebSocket connections.
+	WSConnections metrics.Gauge
+	// Number of active event subscriptions.
+	Subscriptions metrics.Gauge
+	// Number of events dropped because WebSocket client was too slow.
+	DroppedEvents metrics.Counter
+	// Number of WebSocket connections closed because client was too slow.
+	SlowClientDisconnects metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		WSConnections: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "ws_connections",
+			Help:      "Number of open WebSocket connections.",
+		}, labels).With(labelsAndValues...),
+		Subscriptions: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "subscriptions",
+			Help:      "Number of active event subscriptions.",
+		}, labels).With(labelsAndValues...),
+		DroppedEvents: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "dropped_events",
+			Help:      "Number of events dropped because WebSocket client was too slow.",
+		}, labels).With(labelsAndValues...),
+		SlowClientDisconnects: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "slow_client_disconnects",
+			Help:      "Number of WebSocket connections closed because client was too slow.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		WSConnections:         discard.NewGauge(),
+		Subscriptions:         discard.NewGauge(),
+		DroppedEvents:         discard.NewCounter(),
+		SlowClientDisconnects: discard.NewCounter(),
+	}
+}
@@ -4,24 +4,128 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"sync"
 	"time"
 
+	cmcfg "github.com/cometbft/cometbft/config"
 	cmjson "github.com/cometbft/cometbft/libs/json"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/gorilla/rpc/v2"
 	"github.com/gorilla/rpc/v2/json2"
+	"github.com/gorilla/websocket"
 
 	"github.com/rollkit/rollkit/third_party/log"
+	"github.com/rollkit/rollkit/types"
 )
 
 // GetHTTPHandler returns handler configured to serve Tendermint-compatible RPC.
-func GetHTTPHandler(l rpcclient.Client, logger log.Logger) (http.Handler, error) {
-	return newHandler(newService(l, logger), json2.NewCodec(), logger), nil
+func GetHTTPHandler(l rpcclient.Client, logger log.Logger, opts ...Option) (http.Handler, error) {
+	s := newService(l, logger)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return newHandler(s, json2.NewCodec(), logger), nil
+}
+
+// Option configures the handler returned by GetHTTPHandler.
+type Option func(*service)
+
+// WithConfig sets the RPC configuration used to limit subscriptions and configure WebSocket connections.
+func WithConfig(conf *cmcfg.RPCConfig) Option {
+	return func(s *service) {
+		s.config = conf
+	}
+}
+
+// WithMetrics sets the metrics reported by the handler.
+func WithMetrics(metrics *Metrics) Option {
+	return func(s *service) {
+		s.metrics = metrics
+	}
+}
+
+// Authorizer decides if the RPC method can be called in context of the HTTP request.
+// For WebSocket connections, the request used to establish the connection is passed.
+// Calls rejected by Authorizer return its error to the client.
+type Authorizer func(r *http.Request, method string) error
+
+// WithAuthorizer sets the Authorizer checking every RPC method call.
+func WithAuthorizer(authorize Authorizer) Option {
+	return func(s *service) {
+		s.authorize = authorize
+	}
+}
+
+// ErrFinalityNotSupported is returned when the client is not able to report DA finality of blocks.
+var ErrFinalityNotSupported = errors.New("DA finality is not supported by this client")
+
+// ErrProofsNotSupported is returned when the client is not able to build transaction inclusion proofs.
+var ErrProofsNotSupported = errors.New("transaction proofs are not supported by this client")
+
+// ErrEvidenceNotSupported is returned when the client is not able to report equivocation evidence.
+var ErrEvidenceNotSupported = errors.New("equivocation evidence is not supported by this client")
+
+// FinalityClient is implemented by clients able to distinguish soft-confirmed
+// blocks from blocks included in the DA layer.
+type FinalityClient interface {
+	DAIncludedHeight(ctx context.Context) (*types.ResultDAIncludedHeight, error)
+	BlockFinality(ctx context.Context, height *int64) (*types.ResultBlockFinality, error)
+}
+
+// ProofClient is implemented by clients able to build inclusion proofs of all transactions in a block.
+type ProofClient interface {
+	BlockTxProofs(ctx context.Context, height *int64) (*types.ResultBlockTxProofs, error)
+}
+
+// EvidenceClient is implemented by clients able to report sequencer equivocation evidence.
+type EvidenceClient interface {
+	EquivocationEvidence(ctx context.Context, height *int64) (*types.ResultEquivocationEvidence, error)
+}
+
+// ErrFraudProofsNotSupported is returned when the client is not able to report fraud proofs.
+var ErrFraudProofsNotSupported = errors.New("fraud proofs are not supported by this client")
+
+// FraudProofClient is implemented by clients able to report fraud proofs.
+type FraudProofClient interface {
+	FraudProofs(ctx context.Context, height *int64) (*types.ResultFraudProofs, error)
+}
+
+// ErrIntrospectionNotSupported is returned when the client is not able to report Rollkit internals.
+var ErrIntrospectionNotSupported = errors.New("rollkit introspection is not supported by this client")
+
+// IntrospectionClient is implemented by clients able to report state of Rollkit block manager.
+type IntrospectionClient interface {
+	PendingHeaders(ctx context.Context) (*types.ResultPendingHeaders, error)
+	DAStatus(ctx context.Context) (*types.ResultDAStatus, error)
+	BatchQueue(ctx context.Context) (*types.ResultBatchQueue, error)
+	SyncStatus(ctx context.Context) (*types.ResultSyncStatus, error)
+}
+
+// ErrHealthNotSupported is returned when the client is not able to check health of node components.
+var ErrHealthNotSupported = errors.New("health checks are not supported by this client")
+
+// HealthClient is implemented by clients able to check health of node components.
+type HealthClient interface {
+	Liveness(ctx context.Context) (*types.ResultHealth, error)
+	Readiness(ctx context.Context) (*types.ResultHealth, error)
+}
+
+// ErrCursorSearchNotSupported is returned when the client is not able to search using pagination cursors.
+var ErrCursorSearchNotSupported = errors.New("cursor-based search is not supported by this client")
+
+// ErrWebSocketOnly is returned when a method available only over WebSocket is called over HTTP.
+var ErrWebSocketOnly = errors.New("method is available only over WebSocket")
+
+// CursorSearchClient is implemented by clients able to search for transactions and blocks using pagination cursors.
+type CursorSearchClient interface {
+	TxSearchCursor(ctx context.Context, query string, prove bool, cursor string, limit *int, orderBy string) (*types.ResultTxSearchCursor, error)
+	BlockSearchCursor(ctx context.Context, query string, cursor string, limit *int, orderBy string) (*types.ResultBlockSearchCursor, error)
 }
 
 type method struct {
@@ -45,55 +149,78 @@ func newMethod(m interface{}) *method {
 type service struct {
 	client  rpcclient.Client
 	methods map[string]*method
+	config  *cmcfg.RPCConfig
+	metrics *Metrics
 	logger  log.Logger
+	// authorize is nil if calls are not restricted
+	authorize Authorizer
+
+	subscriptionsMtx sync.Mutex
+	// subscriptions holds the number of subscriptions of every client
+	subscriptions map[string]int
 }
 
 func newService(c rpcclient.Client, l log.Logger) *service {
 	s := service{
-		client: c,
-		logger: l,
+		client:        c,
+		config:        cmcfg.DefaultRPCConfig(),
+		metrics:       NopMetrics(),
+		logger:        l,
+		subscriptions: make(map[string]int),
 	}
 	s.methods = map[string]*method{
-		"subscribe":            newMethod(s.Subscribe),
-		"unsubscribe":          newMethod(s.Unsubscribe),
-		"unsubscribe_all":      newMethod(s.UnsubscribeAll),
-		"health":               newMethod(s.Health),
-		"status":               newMethod(s.Status),
-		"net_info":             newMethod(s.NetInfo),
-		"blockchain":           newMethod(s.BlockchainInfo),
-		"genesis":              newMethod(s.Genesis),
-		"genesis_chunked":      newMethod(s.GenesisChunked),
-		"block":                newMethod(s.Block),
-		"block_by_hash":        newMethod(s.BlockByHash),
-		"block_results":        newMethod(s.BlockResults),
-		"commit":               newMethod(s.Commit),
-		"header":               newMethod(s.Header),
-		"header_by_hash":       newMethod(s.HeaderByHash),
-		"check_tx":             newMethod(s.CheckTx),
-		"tx":                   newMethod(s.Tx),
-		"tx_search":            newMethod(s.TxSearch),
-		"block_search":         newMethod(s.BlockSearch),
-		"validators":           newMethod(s.Validators),
-		"dump_consensus_state": newMethod(s.DumpConsensusState),
-		"consensus_state":      newMethod(s.GetConsensusState),
-		"consensus_params":     newMethod(s.ConsensusParams),
-		"unconfirmed_txs":      newMethod(s.UnconfirmedTxs),
-		"num_unconfirmed_txs":  newMethod(s.NumUnconfirmedTxs),
-		"broadcast_tx_commit":  newMethod(s.BroadcastTxCommit),
-		"broadcast_tx_sync":    newMethod(s.BroadcastTxSync),
-		"broadcast_tx_async":   newMethod(s.BroadcastTxAsync),
-		"abci_query":           newMethod(s.ABCIQuery),
-		"abci_info":            newMethod(s.ABCIInfo),
-		"broadcast_evidence":   newMethod(s.BroadcastEvidence),
+		"subscribe":             newMethod(s.Subscribe),
+		"unsubscribe":           newMethod(s.Unsubscribe),
+		"unsubscribe_all":       newMethod(s.UnsubscribeAll),
+		"health":                newMethod(s.Health),
+		"status":                newMethod(s.Status),
+		"net_info":              newMethod(s.NetInfo),
+		"blockchain":            newMethod(s.BlockchainInfo),
+		"genesis":               newMethod(s.Genesis),
+		"genesis_chunked":       newMethod(s.GenesisChunked),
+		"block":                 newMethod(s.Block),
+		"block_by_hash":         newMethod(s.BlockByHash),
+		"block_results":         newMethod(s.BlockResults),
+		"commit":                newMethod(s.Commit),
+		"header":                newMethod(s.Header),
+		"header_by_hash":        newMethod(s.HeaderByHash),
+		"check_tx":              newMethod(s.CheckTx),
+		"tx":                    newMethod(s.Tx),
+		"tx_search":             newMethod(s.TxSearch),
+		"block_search":          newMethod(s.BlockSearch),
+		"tx_search_cursor":      newMethod(s.TxSearchCursor),
+		"block_search_cursor":   newMethod(s.BlockSearchCursor),
+		"tx_search_stream":      newMethod(s.TxSearchStream),
+		"block_search_stream":   newMethod(s.BlockSearchStream),
+		"validators":            newMethod(s.Validators),
+		"dump_consensus_state":  newMethod(s.DumpConsensusState),
+		"consensus_state":       newMethod(s.GetConsensusState),
+		"consensus_params":      newMethod(s.ConsensusParams),
+		"unconfirmed_txs":       newMethod(s.UnconfirmedTxs),
+		"num_unconfirmed_txs":   newMethod(s.NumUnconfirmedTxs),
+		"broadcast_tx_commit":   newMethod(s.BroadcastTxCommit),
+		"broadcast_tx_sync":     newMethod(s.BroadcastTxSync),
+		"broadcast_tx_async":    newMethod(s.BroadcastTxAsync),
+		"abci_query":            newMethod(s.ABCIQuery),
+		"abci_info":             newMethod(s.ABCIInfo),
+		"broadcast_evidence":    newMethod(s.BroadcastEvidence),
+		"da_included_height":    newMethod(s.DAIncludedHeight),
+		"block_finality":        newMethod(s.BlockFinality),
+		"block_tx_proofs":       newMethod(s.BlockTxProofs),
+		"equivocation_evidence": newMethod(s.EquivocationEvidence),
+		"fraud_proofs":          newMethod(s.FraudProofs),
+		// Rollkit introspection API
+		"rollkit_pending_headers": newMethod(s.PendingHeaders),
+		"rollkit_da_status":       newMethod(s.DAStatus),
+		"rollkit_batch_queue":     newMethod(s.BatchQueue),
+		"rollkit_sync_status":     newMethod(s.SyncStatus),
 	}
 	return &s
 }
 
 func (s *service) Subscribe(req *http.Request, args *subscribeArgs, wsConn *wsConn) (*ctypes.ResultSubscribe, error) {
-	// TODO(tzdybal): pass config and check subscriptions limits
 	// TODO(tzdybal): extract consts or configs
 	const SubscribeTimeout = 5 * time.Second
-	const subBufferSize = 100
 
 	addr := req.RemoteAddr
 	var query string
@@ -101,36 +228,41 @@ func (s *service) Subscribe(req *http.Request, args *subscribeArgs, wsConn *wsCo
 		query = *args.Query
 	}
 
+	if err := s.reserveSubscription(addr); err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(req.Context(), SubscribeTimeout)
 	defer cancel()
 
-	sub, err := s.client.Subscribe(ctx, addr, query, subBufferSize)
+	sub, err := s.client.Subscribe(ctx, addr, query, s.config.SubscriptionBufferSize)
 	if err != nil {
+		s.releaseSubscription(addr)
 		return nil, fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	go func() {
-		var codecReq rpc.CodecRequest
-		if wsConn != nil {
-			codecReq = wsConn.codecReq
-		} else {
-			codecReq = json2.NewCodec().NewRequest(req)
-		}
+	// codec request is bound before returning, as wsConn is reused for subsequent requests
+	var codecReq rpc.CodecRequest
+	if wsConn != nil {
+		codecReq = wsConn.codecReq
+	} else {
+		codecReq = json2.NewCodec().NewRequest(req)
+	}
 
+	go func() {
 		for msg := range sub {
-			var raw json.RawMessage
-			raw, err = cmjson.Marshal(msg.Data)
+			raw, err := cmjson.Marshal(msg.Data)
 			btz := new(bytes.Buffer)
 			w := newResponseWriter(btz)
 			if err != nil {
 				codecReq.WriteError(w, http.StatusInternalServerError, err)
 				return
 			}
-			codecReq.WriteResponse(w, raw)
+			codecReq.WriteResponse(w, json.RawMessage(raw))
 
 			data := btz.Bytes()
-			if wsConn != nil {
-				wsConn.queue <- data
+			if wsConn != nil && !wsConn.writeEvent(data) {
+				return
 			}
 		}
 	}()
@@ -150,6 +282,7 @@ func (s *service) Unsubscribe(req *http.Request, args *unsubscribeArgs) (*emptyR
 	if err != nil {
 		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
 	}
+	s.releaseSubscription(req.RemoteAddr)
 	return &emptyResult{}, nil
 }
 
@@ -159,9 +292,66 @@ func (s *service) UnsubscribeAll(req *http.Request, args *unsubscribeAllArgs) (*
 	if err != nil {
 		return nil, fmt.Errorf("failed to unsubscribe all: %w", err)
 	}
+	s.releaseAllSubscriptions(req.RemoteAddr)
 	return &emptyResult{}, nil
 }
 
+// reserveSubscription accounts a new subscription of the client, enforcing limits of subscriptions.
+func (s *service) reserveSubscription(addr string) error {
+	s.subscriptionsMtx.Lock()
+	defer s.subscriptionsMtx.Unlock()
+	n := s.subscriptions[addr]
+	if n == 0 && s.config.MaxSubscriptionClients > 0 && len(s.subscriptions) >= s.config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", s.config.MaxSubscriptionClients)
+	}
+	if s.config.MaxSubscriptionsPerClient > 0 && n >= s.config.MaxSubscriptionsPerClient {
+		return fmt.Errorf("max_subscriptions_per_client %d reached", s.config.MaxSubscriptionsPerClient)
+	}
+	s.subscriptions[addr] = n + 1
+	s.metrics.Subscriptions.Add(1)
+	return nil
+}
+
+// releaseSubscription removes a single subscription of the client from accounting.
+func (s *service) releaseSubscription(addr string) {
+	s.subscriptionsMtx.Lock()
+	defer s.subscriptionsMtx.Unlock()
+	n, ok := s.subscriptions[addr]
+	if !ok {
+		return
+	}
+	if n <= 1 {
+		delete(s.subscriptions, addr)
+	} else {
+		s.subscriptions[addr] = n - 1
+	}
+	s.metrics.Subscriptions.Add(-1)
+}
+
+// releaseAllSubscriptions removes all subscriptions of the client from accounting.
+// It returns false if client had no subscriptions.
+func (s *service) releaseAllSubscriptions(addr string) bool {
+	s.subscriptionsMtx.Lock()
+	defer s.subscriptionsMtx.Unlock()
+	n, ok := s.subscriptions[addr]
+	if !ok {
+		return false
+	}
+	delete(s.subscriptions, addr)
+	s.metrics.Subscriptions.Add(-float64(n))
+	return true
+}
+
+// unsubscribeClient removes all subscriptions of disconnected client.
+func (s *service) unsubscribeClient(addr string) {
+	if !s.releaseAllSubscriptions(addr) {
+		return
+	}
+	if err := s.client.UnsubscribeAll(context.Background(), addr); err != nil {
+		s.logger.Error("failed to unsubscribe disconnected client", "remote", addr, "error", err)
+	}
+}
+
 // info API
 func (s *service) Health(req *http.Request, args *healthArgs) (*ctypes.ResultHealth, error) {
 	return s.client.Health(req.Context())
@@ -286,6 +476,95 @@ func (s *service) BlockSearch(req *http.Request, args *blockSearchArgs) (*ctypes
 	return s.client.BlockSearch(req.Context(), args.Query, page, perPage, orderBy)
 }
 
+func (s *service) TxSearchCursor(req *http.Request, args *txSearchCursorArgs) (*types.ResultTxSearchCursor, error) {
+	cc, ok := s.client.(CursorSearchClient)
+	if !ok {
+		return nil, ErrCursorSearchNotSupported
+	}
+	cursor, limit, orderBy := args.options()
+	return cc.TxSearchCursor(req.Context(), args.Query, args.Prove, cursor, limit, orderBy)
+}
+
+func (s *service) BlockSearchCursor(req *http.Request, args *blockSearchCursorArgs) (*types.ResultBlockSearchCursor, error) {
+	cc, ok := s.client.(CursorSearchClient)
+	if !ok {
+		return nil, ErrCursorSearchNotSupported
+	}
+	cursor, limit, orderBy := args.options()
+	return cc.BlockSearchCursor(req.Context(), args.Query, cursor, limit, orderBy)
+}
+
+// TxSearchStream sends all transactions matching the query to WebSocket client, page by page.
+// Every page is sent as a separate response to the request; the last one has empty next_cursor.
+func (s *service) TxSearchStream(req *http.Request, args *txSearchCursorArgs, wsConn *wsConn) (*types.ResultTxSearchCursor, error) {
+	if wsConn == nil {
+		return nil, ErrWebSocketOnly
+	}
+	cc, ok := s.client.(CursorSearchClient)
+	if !ok {
+		return nil, ErrCursorSearchNotSupported
+	}
+	cursor, limit, orderBy := args.options()
+	return streamPages(wsConn, cursor, func(cursor string) (*types.ResultTxSearchCursor, string, error) {
+		res, err := cc.TxSearchCursor(req.Context(), args.Query, args.Prove, cursor, limit, orderBy)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.NextCursor, nil
+	})
+}
+
+// BlockSearchStream sends all blocks matching the query to WebSocket client, page by page.
+// Every page is sent as a separate response to the request; the last one has empty next_cursor.
+func (s *service) BlockSearchStream(req *http.Request, args *blockSearchCursorArgs, wsConn *wsConn) (*types.ResultBlockSearchCursor, error) {
+	if wsConn == nil {
+		return nil, ErrWebSocketOnly
+	}
+	cc, ok := s.client.(CursorSearchClient)
+	if !ok {
+		return nil, ErrCursorSearchNotSupported
+	}
+	cursor, limit, orderBy := args.options()
+	return streamPages(wsConn, cursor, func(cursor string) (*types.ResultBlockSearchCursor, string, error) {
+		res, err := cc.BlockSearchCursor(req.Context(), args.Query, cursor, limit, orderBy)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.NextCursor, nil
+	})
+}
+
+// streamPages fetches pages of results starting from cursor, until page returns an empty next cursor.
+// All pages but the last one are written to the WebSocket connection as responses to the current request;
+// the last page is returned, so it's written as a regular response. Writes block until the client
+// keeps up, so pages are not dropped and memory usage is bounded by the size of WebSocket queue.
+// Every page is searched from its cursor, so the indexers don't match all results again.
+func streamPages[T any](wsConn *wsConn, cursor string, page func(cursor string) (T, string, error)) (T, error) {
+	// codec request is bound before writing, as wsConn is reused for subsequent requests
+	codecReq := wsConn.codecReq
+	for {
+		res, next, err := page(cursor)
+		if err != nil || next == "" {
+			return res, err
+		}
+		raw, err := cmjson.Marshal(res)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		buf := new(bytes.Buffer)
+		codecReq.WriteResponse(newResponseWriter(buf), json.RawMessage(raw))
+		wsConn.writeResponse(websocket.TextMessage, buf.Bytes())
+		select {
+		case <-wsConn.done:
+			var zero T
+			return zero, errors.New("connection closed")
+		default:
+		}
+		cursor = next
+	}
+}
+
 func (s *service) Validators(req *http.Request, args *validatorsArgs) (*ctypes.ResultValidators, error) {
 	var height *int64
 	var page, perPage *int
@@ -371,3 +650,97 @@ func (s *service) ABCIInfo(req *http.Request, args *ABCIInfoArgs) (*ctypes.Resul
 func (s *service) BroadcastEvidence(req *http.Request, args *broadcastEvidenceArgs) (*ctypes.ResultBroadcastEvidence, error) {
 	return s.client.BroadcastEvidence(req.Context(), args.Evidence)
 }
+
+func (s *service) EquivocationEvidence(req *http.Request, args *equivocationEvidenceArgs) (*types.ResultEquivocationEvidence, error) {
+	ec, ok := s.client.(EvidenceClient)
+	if !ok {
+		return nil, ErrEvidenceNotSupported
+	}
+	var height *int64
+	if args.Height != nil {
+		h := int64(*args.Height)
+		height = &h
+	}
+	return ec.EquivocationEvidence(req.Context(), height)
+}
+
+func (s *service) FraudProofs(req *http.Request, args *fraudProofsArgs) (*types.ResultFraudProofs, error) {
+	fc, ok := s.client.(FraudProofClient)
+	if !ok {
+		return nil, ErrFraudProofsNotSupported
+	}
+	var height *int64
+	if args.Height != nil {
+		h := int64(*args.Height)
+		height = &h
+	}
+	return fc.FraudProofs(req.Context(), height)
+}
+
+// finality API
+func (s *service) DAIncludedHeight(req *http.Request, args *daIncludedHeightArgs) (*types.ResultDAIncludedHeight, error) {
+	fc, ok := s.client.(FinalityClient)
+	if !ok {
+		return nil, ErrFinalityNotSupported
+	}
+	return fc.DAIncludedHeight(req.Context())
+}
+
+func (s *service) BlockTxProofs(req *http.Request, args *blockTxProofsArgs) (*types.ResultBlockTxProofs, error) {
+	pc, ok := s.client.(ProofClient)
+	if !ok {
+		return nil, ErrProofsNotSupported
+	}
+	var height *int64
+	if args.Height != nil {
+		h := int64(*args.Height)
+		height = &h
+	}
+	return pc.BlockTxProofs(req.Context(), height)
+}
+
+func (s *service) BlockFinality(req *http.Request, args *blockFinalityArgs) (*types.ResultBlockFinality, error) {
+	fc, ok := s.client.(FinalityClient)
+	if !ok {
+		return nil, ErrFinalityNotSupported
+	}
+	var height *int64
+	if args.Height != nil {
+		h := int64(*args.Height)
+		height = &h
+	}
+	return fc.BlockFinality(req.Context(), height)
+}
+
+// Rollkit introspection API
+func (s *service) PendingHeaders(req *http.Request, args *pendingHeadersArgs) (*types.ResultPendingHeaders, error) {
+	ic, ok := s.client.(IntrospectionClient)
+	if !ok {
+		return nil, ErrIntrospectionNotSupported
+	}
+	return ic.PendingHeaders(req.Context())
+}
+
+func (s *service) DAStatus(req *http.Request, args *daStatusArgs) (*types.ResultDAStatus, error) {
+	ic, ok := s.client.(IntrospectionClient)
+	if !ok {
+		return nil, ErrIntrospectionNotSupported
+	}
+	return ic.DAStatus(req.Context())
+}
+
+func (s *service) BatchQueue(req *http.Request, args *batchQueueArgs) (*types.ResultBatchQueue, error) {
+	ic, ok := s.client.(IntrospectionClient)
+	if !ok {
+		return nil, ErrIntrospectionNotSupported
+	}
+	return ic.BatchQueue(req.Context())
+}
+
+func (s *service) SyncStatus(req *http.Request, args *syncStatusArgs) (*types.ResultSyncStatus, error) {
+	ic, ok := s.client.(IntrospectionClient)
+	if !ok {
+		return nil, ErrIntrospectionNotSupported
+	}
+	return ic.SyncStatus(req.Context())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -13,12 +14,16 @@ import (
 	"testing"
 	"time"
 
+	cmcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/p2p"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/rollkit/rollkit/node"
 	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -80,6 +85,10 @@ func TestREST(t *testing.T) {
 			http.StatusOK, int(json2.E_PARSE), "failed to parse param 'prove'"},
 		{"valid/hex param", "/check_tx?tx=DEADBEEF", http.StatusOK, -1, `"gas_used":"1000"`},
 		{"invalid/hex param", "/check_tx?tx=QWERTY", http.StatusOK, int(json2.E_PARSE), "failed to parse param 'tx'"},
+		{"valid/da included height", "/da_included_height", http.StatusOK, -1, `"height":"0"`},
+		{"valid/block finality", "/block_finality?height=321", http.StatusOK, int(json2.E_INTERNAL), "block at height 321 not found"},
+		{"valid/equivocation evidence", "/equivocation_evidence?height=321", http.StatusOK, -1, `"evidence":null`},
+		{"valid/fraud proofs", "/fraud_proofs?height=321", http.StatusOK, -1, `"fraud_proofs":null`},
 	}
 
 	_, local := getRPC(t)
@@ -145,6 +154,183 @@ func TestStringyRequest(t *testing.T) {
 	assert.Equal(respJSON, resp.Body.String())
 }
 
+func TestBatchRequest(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	conf := cmcfg.DefaultRPCConfig()
+	conf.MaxRequestBatchSize = 3
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger(), WithConfig(conf))
+	require.NoError(err)
+
+	serve := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		return resp
+	}
+
+	// notifications (requests without id) don't have responses
+	resp := serve(`[
+		{"jsonrpc":"2.0","id":1,"method":"health","params":{}},
+		{"jsonrpc":"2.0","method":"health","params":{}},
+		{"jsonrpc":"2.0","id":"two","method":"no_such_method","params":{}}
+	]`)
+	assert.Equal(http.StatusOK, resp.Code)
+	var responses []response
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &responses))
+	require.Len(responses, 2)
+	assert.Equal(json.RawMessage("1"), responses[0].ID)
+	assert.Nil(responses[0].Error)
+	assert.JSONEq("{}", string(responses[0].Result))
+	assert.Equal(json.RawMessage(`"two"`), responses[1].ID)
+	require.NotNil(responses[1].Error)
+	assert.Equal(json2.E_NO_METHOD, responses[1].Error.Code)
+
+	resp = serve(`[{"jsonrpc":"2.0","method":"health","params":{}}]`)
+	assert.Equal(http.StatusOK, resp.Code)
+	assert.Empty(resp.Body.String())
+
+	for _, body := range []string{
+		`[]`,
+		`[{}, {}, {}, {}]`,
+		`[{"jsonrpc":"2.0"`,
+	} {
+		resp = serve(body)
+		assert.Equal(http.StatusOK, resp.Code)
+		var errResp response
+		require.NoError(json.Unmarshal(resp.Body.Bytes(), &errResp), body)
+		assert.Equal(json.RawMessage("null"), errResp.ID)
+		assert.NotNil(errResp.Error, body)
+	}
+}
+
+func TestIntrospection(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger())
+	require.NoError(err)
+
+	for _, method := range []string{
+		"rollkit_pending_headers",
+		"rollkit_da_status",
+		"rollkit_batch_queue",
+		"rollkit_sync_status",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/"+method, nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		assert.Equal(http.StatusOK, resp.Code, method)
+		var jsonResp response
+		require.NoError(json.Unmarshal(resp.Body.Bytes(), &jsonResp), method)
+		assert.Nil(jsonResp.Error, method)
+		assert.NotEmpty(jsonResp.Result, method)
+	}
+
+	jsonReq, err := json2.EncodeClientRequest("rollkit_sync_status", &syncStatusArgs{})
+	require.NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(jsonReq))
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	var jsonResp response
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &jsonResp))
+	require.Nil(jsonResp.Error)
+	var status types.ResultSyncStatus
+	require.NoError(cmjson.Unmarshal(jsonResp.Result, &status))
+	assert.LessOrEqual(status.DAIncludedHeight, status.Height)
+}
+
+func TestAuthorizer(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	errDenied := errors.New("denied")
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger(), WithAuthorizer(func(r *http.Request, method string) error {
+		if method == "status" {
+			return errDenied
+		}
+		return nil
+	}))
+	require.NoError(err)
+
+	serve := func(req *http.Request) response {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		var r response
+		require.NoError(json.Unmarshal(resp.Body.Bytes(), &r))
+		return r
+	}
+
+	resp := serve(httptest.NewRequest(http.MethodGet, "/status", nil))
+	require.NotNil(resp.Error)
+	assert.Contains(resp.Error.Data, errDenied.Error())
+
+	resp = serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"status","params":{}}`)))
+	require.NotNil(resp.Error)
+	assert.Equal(errDenied.Error(), resp.Error.Message)
+
+	resp = serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"health","params":{}}`)))
+	assert.Nil(resp.Error)
+}
+
+func TestSyncHealth(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger())
+	require.NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/health/sync", nil)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	// aggregator is never catching up
+	assert.Equal(http.StatusOK, resp.Code)
+	var status types.ResultSyncStatus
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &status))
+	assert.False(status.CatchingUp)
+}
+
+func TestComponentHealth(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger())
+	require.NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	assert.Equal(http.StatusOK, resp.Code)
+	var health types.ResultHealth
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &health))
+	assert.True(health.Healthy)
+	assert.Len(health.Components, 2)
+	assert.True(health.Components[node.HealthABCI].Healthy)
+	assert.True(health.Components[node.HealthBlockAge].Healthy)
+
+	// sequencer is not running in tests
+	req = httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	assert.Equal(http.StatusServiceUnavailable, resp.Code, resp.Body.String())
+	health = types.ResultHealth{}
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &health))
+	assert.False(health.Healthy)
+	assert.False(health.Components[node.HealthSequencer].Healthy, health.Components[node.HealthSequencer].Message)
+	for _, name := range []string{node.HealthABCI, node.HealthDA, node.HealthP2P, node.HealthIndexer, node.HealthSync} {
+		assert.True(health.Components[name].Healthy, name)
+	}
+}
+
 func TestSubscription(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -87,6 +87,43 @@ type blockSearchArgs struct {
 	OrderBy *string `json:"order_by"`
 }
 
+type txSearchCursorArgs struct {
+	Query   string  `json:"query"`
+	Prove   bool    `json:"prove"`
+	Cursor  *string `json:"cursor"`
+	Limit   *StrInt `json:"limit"`
+	OrderBy *string `json:"order_by"`
+}
+
+func (a *txSearchCursorArgs) options() (cursor string, limit *int, orderBy string) {
+	return cursorOptions(a.Cursor, a.Limit, a.OrderBy)
+}
+
+type blockSearchCursorArgs struct {
+	Query   string  `json:"query"`
+	Cursor  *string `json:"cursor"`
+	Limit   *StrInt `json:"limit"`
+	OrderBy *string `json:"order_by"`
+}
+
+func (a *blockSearchCursorArgs) options() (cursor string, limit *int, orderBy string) {
+	return cursorOptions(a.Cursor, a.Limit, a.OrderBy)
+}
+
+func cursorOptions(cursorPtr *string, limitPtr *StrInt, orderByPtr *string) (cursor string, limit *int, orderBy string) {
+	if cursorPtr != nil {
+		cursor = *cursorPtr
+	}
+	if limitPtr != nil {
+		l := int(*limitPtr)
+		limit = &l
+	}
+	if orderByPtr != nil {
+		orderBy = *orderByPtr
+	}
+	return cursor, limit, orderBy
+}
+
 type validatorsArgs struct {
 	Height  *StrInt64 `json:"height"`
 	Page    *StrInt   `json:"page"`
@@ -138,6 +175,33 @@ type broadcastEvidenceArgs struct {
 	Evidence types.Evidence `json:"evidence"`
 }
 
+type equivocationEvidenceArgs struct {
+	Height *StrInt64 `json:"height"`
+}
+
+type fraudProofsArgs struct {
+	Height *StrInt64 `json:"height"`
+}
+
+// finality API
+
+type daIncludedHeightArgs struct{}
+
+type blockFinalityArgs struct {
+	Height *StrInt64 `json:"height"`
+}
+
+type blockTxProofsArgs struct {
+	Height *StrInt64 `json:"height"`
+}
+
+// Rollkit introspection API
+
+type pendingHeadersArgs struct{}
+type daStatusArgs struct{}
+type batchQueueArgs struct{}
+type syncStatusArgs struct{}
+
 type emptyResult struct{}
 
 // JSON-deserialization specific types
@@ -2,8 +2,13 @@ package json
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -12,36 +17,137 @@ import (
 	"github.com/rollkit/rollkit/third_party/log"
 )
 
+const (
+	// wsReadBufferSize and wsWriteBufferSize are sizes (in bytes) of WebSocket I/O buffers.
+	wsReadBufferSize  = 1024
+	wsWriteBufferSize = 1024
+
+	// wsWriteWait is the time allowed to write a message to the client.
+	wsWriteWait = 10 * time.Second
+	// wsReadWait is the time allowed to read the next message (or pong) from the client.
+	wsReadWait = 30 * time.Second
+	// wsPingPeriod is the period of sending pings to the client. Must be less than wsReadWait.
+	wsPingPeriod = (wsReadWait * 9) / 10
+)
+
 type wsConn struct {
 	conn     *websocket.Conn
 	codecReq rpc.CodecRequest
-	queue    chan []byte
+	queue    chan wsMessage
 	logger   log.Logger
+
+	// closeOnSlowClient defines if connection is closed when client doesn't keep up with events (instead of dropping events)
+	closeOnSlowClient bool
+	metrics           *Metrics
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// wsMessage is a message queued for sending to WebSocket client.
+type wsMessage struct {
+	// messageType is websocket.TextMessage or websocket.BinaryMessage
+	messageType int
+	data        []byte
+}
+
+func newWSConn(conn *websocket.Conn, queueSize int, closeOnSlowClient bool, metrics *Metrics, logger log.Logger) *wsConn {
+	return &wsConn{
+		conn:              conn,
+		queue:             make(chan wsMessage, queueSize),
+		logger:            logger,
+		closeOnSlowClient: closeOnSlowClient,
+		metrics:           metrics,
+		done:              make(chan struct{}),
+	}
 }
 
 func (wsc *wsConn) sendLoop() {
-	for msg := range wsc.queue {
-		writer, err := wsc.conn.NextWriter(websocket.TextMessage)
-		if err != nil {
-			wsc.logger.Error("failed to create writer", "error", err)
-			continue
-		}
-		_, err = writer.Write(msg)
-		if err != nil {
-			wsc.logger.Error("failed to write message", "error", err)
-		}
-		if err = writer.Close(); err != nil {
-			wsc.logger.Error("failed to close writer", "error", err)
+	pingTicker := time.NewTicker(wsPingPeriod)
+	defer pingTicker.Stop()
+	for {
+		select {
+		case <-wsc.done:
+			return
+		case msg := <-wsc.queue:
+			if err := wsc.write(msg); err != nil {
+				wsc.logger.Error("failed to write message", "error", err)
+				wsc.close()
+				return
+			}
+		case <-pingTicker.C:
+			if err := wsc.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				wsc.logger.Error("failed to write ping", "error", err)
+				wsc.close()
+				return
+			}
 		}
 	}
 }
 
+func (wsc *wsConn) write(msg wsMessage) error {
+	if err := wsc.conn.SetWriteDeadline(time.Now().Add(wsWriteWait)); err != nil {
+		return err
+	}
+	writer, err := wsc.conn.NextWriter(msg.messageType)
+	if err != nil {
+		return err
+	}
+	if _, err = writer.Write(msg.data); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// writeResponse queues response to a request. It blocks until the response is queued or connection is closed.
+func (wsc *wsConn) writeResponse(messageType int, msg []byte) {
+	select {
+	case wsc.queue <- wsMessage{messageType: messageType, data: msg}:
+	case <-wsc.done:
+	}
+}
+
+// writeEvent queues subscription event without blocking. If the client doesn't keep up with events,
+// the event is dropped or the connection is closed (see closeOnSlowClient).
+// It returns false if connection is closed.
+func (wsc *wsConn) writeEvent(msg []byte) bool {
+	select {
+	case <-wsc.done:
+		return false
+	default:
+	}
+	select {
+	case wsc.queue <- wsMessage{messageType: websocket.TextMessage, data: msg}:
+		return true
+	default:
+	}
+	if wsc.closeOnSlowClient {
+		wsc.logger.Error("WebSocket client is too slow, closing connection", "remote", wsc.conn.RemoteAddr().String())
+		wsc.metrics.SlowClientDisconnects.Add(1)
+		wsc.close()
+		return false
+	}
+	wsc.logger.Error("WebSocket client is too slow, dropping event", "remote", wsc.conn.RemoteAddr().String())
+	wsc.metrics.DroppedEvents.Add(1)
+	return true
+}
+
+// close closes the connection and stops sending messages. It's safe to call close multiple times.
+func (wsc *wsConn) close() {
+	wsc.closeOnce.Do(func() {
+		close(wsc.done)
+		if err := wsc.conn.Close(); err != nil {
+			wsc.logger.Error("failed to close WebSocket connection", "error", err)
+		}
+	})
+}
+
 func (h *handler) wsHandler(w http.ResponseWriter, r *http.Request) {
-	// TODO(tzdybal): configuration options
+	conf := h.srv.config
 	upgrader := websocket.Upgrader{
-		ReadBufferSize:  1024,
-		WriteBufferSize: 1024,
-		CheckOrigin:     func(r *http.Request) bool { return true },
+		ReadBufferSize:  wsReadBufferSize,
+		WriteBufferSize: wsWriteBufferSize,
+		CheckOrigin:     h.checkOrigin,
 	}
 
 	wsc, err := upgrader.Upgrade(w, r, nil)
@@ -50,44 +156,96 @@ func (h *handler) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	remoteAddr := wsc.RemoteAddr().String()
+
+	ws := newWSConn(wsc, conf.WebSocketWriteBufferSize, conf.CloseOnSlowClient, h.srv.metrics, h.logger)
+	h.srv.metrics.WSConnections.Add(1)
 	defer func() {
-		err := wsc.Close()
-		if err != nil {
-			h.logger.Error("failed to close WebSocket connection", "err")
-		}
+		ws.close()
+		h.srv.unsubscribeClient(remoteAddr)
+		h.srv.metrics.WSConnections.Add(-1)
 	}()
 
-	ws := &wsConn{
-		conn:   wsc,
-		queue:  make(chan []byte),
-		logger: h.logger,
+	if conf.MaxBodyBytes > 0 {
+		wsc.SetReadLimit(conf.MaxBodyBytes)
 	}
+	wsc.SetPongHandler(func(string) error {
+		return wsc.SetReadDeadline(time.Now().Add(wsReadWait))
+	})
 	go ws.sendLoop()
 
 	for {
-		mt, r, err := wsc.NextReader()
+		if err := wsc.SetReadDeadline(time.Now().Add(wsReadWait)); err != nil {
+			h.logger.Error("failed to set read deadline", "error", err)
+			break
+		}
+		mt, msg, err := wsc.NextReader()
 		if err != nil {
-			h.logger.Error("failed to read next WebSocket message", "error", err)
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) && !errors.Is(err, net.ErrClosed) {
+				h.logger.Error("failed to read next WebSocket message", "error", err)
+			}
 			break
 		}
 
-		if mt != websocket.TextMessage {
-			// TODO(tzdybal): https://github.com/rollkit/rollkit/issues/465
-			h.logger.Debug("expected text message")
+		if mt != websocket.TextMessage && mt != websocket.BinaryMessage {
+			h.logger.Debug("expected text or binary message")
 			continue
 		}
-		req, err := http.NewRequest(http.MethodGet, "", r)
-		req.RemoteAddr = remoteAddr
+		req, err := http.NewRequest(http.MethodGet, "", msg)
 		if err != nil {
 			h.logger.Error("failed to create request", "error", err)
 			continue
 		}
+		// calls are authorized with the headers of the request establishing the connection
+		req.Header = r.Header.Clone()
+		req.RemoteAddr = remoteAddr
+
+		if mt == websocket.BinaryMessage {
+			resp, err := h.serveBinary(req, ws)
+			if err != nil {
+				h.logger.Error("failed to serve binary request", "error", err)
+				continue
+			}
+			if resp != nil {
+				ws.writeResponse(websocket.BinaryMessage, resp)
+			}
+			continue
+		}
 
 		writer := new(bytes.Buffer)
 		h.serveJSONRPCforWS(newResponseWriter(writer), req, ws)
-		ws.queue <- writer.Bytes()
+		if writer.Len() > 0 {
+			ws.writeResponse(websocket.TextMessage, writer.Bytes())
+		}
+	}
+}
+
+// checkOrigin verifies origin of WebSocket connection against CORSAllowedOrigins.
+// All origins are allowed if CORS is not configured.
+func (h *handler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || !h.srv.config.IsCorsEnabled() {
+		return true
+	}
+	for _, allowed := range h.srv.config.CORSAllowedOrigins {
+		if originMatches(allowed, origin) {
+			return true
+		}
 	}
+	h.logger.Debug("WebSocket origin not allowed", "origin", origin)
+	return false
+}
 
+// originMatches checks if origin matches allowed origin, which may contain a single wildcard ("*").
+func originMatches(allowed, origin string) bool {
+	allowed = strings.ToLower(allowed)
+	origin = strings.ToLower(origin)
+	if i := strings.IndexByte(allowed, '*'); i >= 0 {
+		prefix, suffix := allowed[:i], allowed[i+1:]
+		return len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) &&
+			strings.HasSuffix(origin, suffix)
+	}
+	return allowed == origin
 }
 
 func newResponseWriter(w io.Writer) http.ResponseWriter {
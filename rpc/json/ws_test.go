@@ -2,13 +2,16 @@ package json
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	cmcfg "github.com/cometbft/cometbft/config"
 	cmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/go-kit/kit/transport/http/jsonrpc"
 
@@ -18,6 +21,9 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
 )
 
 func TestWebSockets(t *testing.T) {
@@ -88,3 +94,286 @@ func TestWebSockets(t *testing.T) {
 	require.NoError(json.Unmarshal(rsp.Body.Bytes(), &jsonResp))
 	assert.Nil(jsonResp.Error)
 }
+
+func TestWebSocketBatchAndBinary(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	h, err := GetHTTPHandler(local, log.TestingLogger())
+	require.NoError(err)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1)+"/websocket", nil)
+	require.NoError(err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	request := func(typ int, req string) (int, []byte) {
+		require.NoError(conn.WriteMessage(typ, []byte(req)))
+		require.NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+		respTyp, msg, err := conn.ReadMessage()
+		require.NoError(err)
+		return respTyp, msg
+	}
+
+	// JSON-RPC batch in text frame
+	typ, msg := request(websocket.TextMessage, `[
+		{"jsonrpc":"2.0","id":1,"method":"health","params":{}},
+		{"jsonrpc":"2.0","id":2,"method":"status","params":{}}
+	]`)
+	assert.Equal(websocket.TextMessage, typ)
+	var responses []response
+	require.NoError(json.Unmarshal(msg, &responses))
+	require.Len(responses, 2)
+	assert.Nil(responses[0].Error)
+	assert.Nil(responses[1].Error)
+	assert.Equal(json.RawMessage("2"), responses[1].ID)
+
+	// methods without binary encoding return JSON encoded result
+	typ, msg = request(websocket.BinaryMessage, `{"jsonrpc":"2.0","id":"h","method":"health","params":{}}`)
+	assert.Equal(websocket.BinaryMessage, typ)
+	var resp pb.RPCResponse
+	require.NoError(resp.Unmarshal(msg))
+	assert.Equal([]byte(`"h"`), resp.Id)
+	assert.Nil(resp.Error)
+	assert.JSONEq("{}", string(resp.JsonResult))
+
+	// wait for the first block
+	require.Eventually(func() bool {
+		_, msg := request(websocket.BinaryMessage, `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"1"}}`)
+		resp = pb.RPCResponse{}
+		require.NoError(resp.Unmarshal(msg))
+		return resp.Error == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	require.NotNil(resp.Block)
+	require.NotNil(resp.Block.Block)
+	assert.Equal(int64(1), resp.Block.Block.Header.Height)
+	assert.NotEmpty(resp.Block.BlockId.Hash)
+	assert.Empty(resp.JsonResult)
+
+	typ, msg = request(websocket.BinaryMessage, `[
+		{"jsonrpc":"2.0","id":1,"method":"block_results","params":{"height":"1"}},
+		{"jsonrpc":"2.0","method":"health","params":{}},
+		{"jsonrpc":"2.0","id":3,"method":"no_such_method","params":{}}
+	]`)
+	assert.Equal(websocket.BinaryMessage, typ)
+	var batch pb.RPCBatchResponse
+	require.NoError(batch.Unmarshal(msg))
+	require.Len(batch.Responses, 2)
+	require.NotNil(batch.Responses[0].BlockResults)
+	assert.Equal(int64(1), batch.Responses[0].BlockResults.Height)
+	assert.Equal([]byte("3"), batch.Responses[1].Id)
+	require.NotNil(batch.Responses[1].Error)
+	assert.Equal(int32(json2.E_NO_METHOD), batch.Responses[1].Error.Code)
+}
+
+func TestWebSocketSearchStream(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	h, err := GetHTTPHandler(local, log.TestingLogger())
+	require.NoError(err)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	const query = "block.height >= 1 AND block.height <= 3"
+	require.Eventually(func() bool {
+		res, err := local.BlockSearch(context.Background(), query, nil, nil, "")
+		return err == nil && res.TotalCount == 3
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// streaming is not available over HTTP
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/block_search_stream?query=%22block.height%3E%3D1%22", nil))
+	var httpResp response
+	require.NoError(json.Unmarshal(resp.Body.Bytes(), &httpResp))
+	require.NotNil(httpResp.Error)
+	assert.Contains(httpResp.Error.Data, ErrWebSocketOnly.Error())
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1)+"/websocket", nil)
+	require.NoError(err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	require.NoError(conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":5,"method":"block_search_stream","params":{"query":"`+query+`","limit":"1"}}`)))
+	var heights []int64
+	for {
+		require.NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+		_, msg, err := conn.ReadMessage()
+		require.NoError(err)
+		var jsonResp response
+		require.NoError(json.Unmarshal(msg, &jsonResp))
+		require.Nil(jsonResp.Error)
+		assert.Equal(json.RawMessage("5"), jsonResp.ID)
+		var page types.ResultBlockSearchCursor
+		require.NoError(cmjson.Unmarshal(jsonResp.Result, &page))
+		require.Len(page.Blocks, 1)
+		heights = append(heights, page.Blocks[0].Block.Height)
+		if page.NextCursor == "" {
+			break
+		}
+	}
+	assert.Equal([]int64{1, 2, 3}, heights)
+}
+
+func TestWebSocketSubscriptionLimits(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	conf := cmcfg.DefaultRPCConfig()
+	conf.MaxSubscriptionsPerClient = 1
+	_, local := getRPC(t)
+	h, err := GetHTTPHandler(local, log.TestingLogger(), WithConfig(conf))
+	require.NoError(err)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1)+"/websocket", nil)
+	require.NoError(err)
+
+	subscribe := func(query string) *json2.Error {
+		req, err := json2.EncodeClientRequest("subscribe", &subscribeArgs{Query: &query})
+		require.NoError(err)
+		require.NoError(conn.WriteMessage(websocket.TextMessage, req))
+		require.NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+		_, msg, err := conn.ReadMessage()
+		require.NoError(err)
+		resp := response{}
+		require.NoError(json.Unmarshal(msg, &resp))
+		return resp.Error
+	}
+
+	assert.Nil(subscribe("tm.event='NewBlock'"))
+	rpcErr := subscribe("tm.event='Tx'")
+	require.NotNil(rpcErr)
+	assert.Contains(rpcErr.Message, "max_subscriptions_per_client 1 reached")
+
+	// subscriptions are removed when client disconnects
+	srvc := h.(*handler).srv
+	require.NoError(conn.Close())
+	assert.Eventually(func() bool {
+		srvc.subscriptionsMtx.Lock()
+		defer srvc.subscriptionsMtx.Unlock()
+		return len(srvc.subscriptions) == 0
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestWebSocketCheckOrigin(t *testing.T) {
+	require := require.New(t)
+
+	conf := cmcfg.DefaultRPCConfig()
+	conf.CORSAllowedOrigins = []string{"https://*.rollkit.dev"}
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger(), WithConfig(conf))
+	require.NoError(err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	url := strings.Replace(srv.URL, "http://", "ws://", 1) + "/websocket"
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": []string{"https://app.rollkit.dev"}})
+	require.NoError(err)
+	require.NoError(conn.Close())
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": []string{"https://evil.example"}})
+	require.Error(err)
+	require.NotNil(resp)
+	require.Equal(http.StatusForbidden, resp.StatusCode)
+}
+
+func TestWebSocketAuthorization(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	_, local := getRPC(t)
+	handler, err := GetHTTPHandler(local, log.TestingLogger(), WithAuthorizer(func(r *http.Request, method string) error {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			return errors.New("unauthorized")
+		}
+		return nil
+	}))
+	require.NoError(err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	url := strings.Replace(srv.URL, "http://", "ws://", 1) + "/websocket"
+
+	call := func(header http.Header) response {
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		require.NoError(err)
+		defer func() {
+			_ = conn.Close()
+		}()
+		require.NoError(conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"health","params":{}}`)))
+		require.NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+		_, msg, err := conn.ReadMessage()
+		require.NoError(err)
+		var resp response
+		require.NoError(json.Unmarshal(msg, &resp))
+		return resp
+	}
+
+	// the token of the upgrade request applies to all calls on the connection
+	assert.Nil(call(http.Header{"Authorization": []string{"Bearer secret"}}).Error)
+
+	resp := call(nil)
+	require.NotNil(resp.Error)
+	assert.Equal("unauthorized", resp.Error.Message)
+}
+
+func TestOriginMatches(t *testing.T) {
+	cases := []struct {
+		allowed string
+		origin  string
+		match   bool
+	}{
+		{"*", "https://example.com", true},
+		{"https://example.com", "https://example.com", true},
+		{"https://example.com", "HTTPS://EXAMPLE.COM", true},
+		{"https://example.com", "https://example.org", false},
+		{"https://*.example.com", "https://app.example.com", true},
+		{"https://*.example.com", "https://example.com", false},
+		{"http://localhost:*", "http://localhost:3000", true},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.match, originMatches(c.allowed, c.origin), "%s vs %s", c.allowed, c.origin)
+	}
+}
+
+func TestWSConnSlowClient(t *testing.T) {
+	require := require.New(t)
+
+	conns := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		require.NoError(err)
+		conns <- conn
+	}))
+	defer srv.Close()
+	client, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1), nil)
+	require.NoError(err)
+	defer func() {
+		_ = client.Close()
+	}()
+	conn := <-conns
+
+	// events are dropped if queue is full
+	wsc := newWSConn(conn, 1, false, NopMetrics(), log.TestingLogger())
+	require.True(wsc.writeEvent([]byte("1")))
+	require.True(wsc.writeEvent([]byte("2")))
+	require.Len(wsc.queue, 1)
+
+	// or connection is closed
+	wsc = newWSConn(conn, 1, true, NopMetrics(), log.TestingLogger())
+	require.True(wsc.writeEvent([]byte("1")))
+	require.False(wsc.writeEvent([]byte("2")))
+	select {
+	case <-wsc.done:
+	default:
+		t.Fatal("connection of slow client not closed")
+	}
+	require.False(wsc.writeEvent([]byte("3")))
+}
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiterCleanupInterval defines how often buckets of inactive clients are removed.
+const rateLimiterCleanupInterval = time.Minute
+
+// rateLimiter implements token bucket rate limiting, with separate bucket for every key.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	now   func() time.Time
+
+	mtx         sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates rateLimiter allowing rate calls per second, and at most burst calls at once.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(max(burst, 1)),
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow takes a token from the bucket of key. It returns false if the bucket is empty.
+func (l *rateLimiter) allow(key string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *rateLimiter) refill(b *bucket, now time.Time) float64 {
+	return min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+}
+
+// cleanup removes full buckets, as they are equivalent to buckets of new clients.
+func (l *rateLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < rateLimiterCleanupInterval {
+		return
+	}
+	l.lastCleanup = now
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -14,31 +14,49 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/rs/cors"
 	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
 
 	"github.com/rollkit/rollkit/node"
+	rollgrpc "github.com/rollkit/rollkit/rpc/grpc"
 	"github.com/rollkit/rollkit/rpc/json"
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
 )
 
 // Server handles HTTP and JSON-RPC requests, exposing Tendermint-compatible API.
+// If gRPC listen address is configured, it also serves native Rollkit gRPC API.
 type Server struct {
 	*service.BaseService
 
 	config *config.RPCConfig
+	node   node.Node
 	client rpcclient.Client
+	opts   []json.Option
 
-	server http.Server
+	accessControl *AccessControl
+
+	server     http.Server
+	grpcServer *grpc.Server
 }
 
 // NewServer creates new instance of Server with given configuration.
-func NewServer(node node.Node, config *config.RPCConfig, logger log.Logger) *Server {
+// Options are passed to the JSON-RPC handler (see json.GetHTTPHandler).
+func NewServer(node node.Node, config *config.RPCConfig, logger log.Logger, opts ...json.Option) *Server {
 	srv := &Server{
 		config: config,
+		node:   node,
 		client: node.GetClient(),
+		opts:   opts,
 	}
 	srv.BaseService = service.NewBaseService(logger, "RPC", srv)
 	return srv
 }
 
+// SetAccessControl sets the access control checking calls of both JSON-RPC and gRPC APIs.
+// It must be called before the server is started.
+func (s *Server) SetAccessControl(ac *AccessControl) {
+	s.accessControl = ac
+}
+
 // Client returns a Tendermint-compatible rpc Client instance.
 //
 // This method is called in cosmos-sdk.
@@ -48,7 +66,10 @@ func (s *Server) Client() rpcclient.Client {
 
 // OnStart is called when Server is started (see service.BaseService for details).
 func (s *Server) OnStart() error {
-	return s.startRPC()
+	if err := s.startRPC(); err != nil {
+		return err
+	}
+	return s.startGRPC()
 }
 
 // OnStop is called when Server is stopped (see service.BaseService for details).
@@ -58,6 +79,9 @@ func (s *Server) OnStop() {
 	if err := s.server.Shutdown(ctx); err != nil {
 		s.Logger.Error("error while shutting down RPC server", "error", err)
 	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
 }
 
 func (s *Server) startRPC() error {
@@ -65,24 +89,16 @@ func (s *Server) startRPC() error {
 		s.Logger.Info("Listen address not specified - RPC will not be exposed")
 		return nil
 	}
-	parts := strings.SplitN(s.config.ListenAddress, "://", 2)
-	if len(parts) != 2 {
-		return errors.New("invalid RPC listen address: expecting tcp://host:port")
-	}
-	proto := parts[0]
-	addr := parts[1]
-
-	listener, err := net.Listen(proto, addr)
+	listener, err := s.listen(s.config.ListenAddress, s.config.MaxOpenConnections)
 	if err != nil {
 		return err
 	}
 
-	if s.config.MaxOpenConnections != 0 {
-		s.Logger.Debug("limiting number of connections", "limit", s.config.MaxOpenConnections)
-		listener = netutil.LimitListener(listener, s.config.MaxOpenConnections)
+	opts := append([]json.Option{json.WithConfig(s.config)}, s.opts...)
+	if s.accessControl != nil {
+		opts = append(opts, json.WithAuthorizer(s.accessControl.Authorize))
 	}
-
-	handler, err := json.GetHTTPHandler(s.client, s.Logger)
+	handler, err := json.GetHTTPHandler(s.client, s.Logger, opts...)
 	if err != nil {
 		return err
 	}
@@ -111,6 +127,58 @@ func (s *Server) startRPC() error {
 	return nil
 }
 
+func (s *Server) startGRPC() error {
+	if s.config.GRPCListenAddress == "" {
+		return nil
+	}
+	n, ok := s.node.(rollgrpc.Node)
+	if !ok {
+		s.Logger.Info("gRPC API is not supported by the node - gRPC will not be exposed")
+		return nil
+	}
+	listener, err := s.listen(s.config.GRPCListenAddress, s.config.GRPCMaxOpenConnections)
+	if err != nil {
+		return err
+	}
+
+	var grpcOpts []grpc.ServerOption
+	if s.accessControl != nil {
+		grpcOpts = s.accessControl.GRPCServerOptions()
+	}
+	s.grpcServer = grpc.NewServer(grpcOpts...)
+	pb.RegisterRollkitServiceServer(s.grpcServer, rollgrpc.NewService(n, s.Logger))
+	go func() {
+		s.Logger.Info("serving gRPC", "listen address", listener.Addr())
+		if err := s.grpcServer.Serve(listener); err != nil {
+			s.Logger.Error("error while serving gRPC", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// listen creates listener for address in tcp://host:port format, limiting the number
+// of open connections if maxOpenConnections is not 0.
+func (s *Server) listen(listenAddress string, maxOpenConnections int) (net.Listener, error) {
+	parts := strings.SplitN(listenAddress, "://", 2)
+	if len(parts) != 2 {
+		return nil, errors.New("invalid RPC listen address: expecting tcp://host:port")
+	}
+	proto := parts[0]
+	addr := parts[1]
+
+	listener, err := net.Listen(proto, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxOpenConnections != 0 {
+		s.Logger.Debug("limiting number of connections", "limit", maxOpenConnections)
+		listener = netutil.LimitListener(listener, maxOpenConnections)
+	}
+	return listener, nil
+}
+
 func (s *Server) serve(listener net.Listener, handler http.Handler) error {
 	s.Logger.Info("serving HTTP", "listen address", listener.Addr())
 	s.server = http.Server{
@@ -0,0 +1,143 @@
+package signer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/cometbft/cometbft/privval"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+)
+
+// stepPrecommit is the step of precommit votes in CometBFT private validator state files.
+const stepPrecommit = 3
+
+// LocalSigner signs headers with a private key held in memory.
+//
+// The last signed header is saved in a state file, in the format of CometBFT private validator
+// state files, so the signer refuses to sign a header below the last signed height, or a
+// different header at the same height, even after a restart. Signing the same header again
+// returns the saved signature.
+type LocalSigner struct {
+	privKey   cmcrypto.PrivKey
+	stateFile string
+
+	mtx   sync.Mutex
+	state privval.FilePVLastSignState
+}
+
+var _ Signer = &LocalSigner{}
+
+// NewLocalSigner returns a signer using privKey, loading the last signed header from stateFile
+// if it exists. If stateFile is empty, the last signed header is kept in memory only.
+func NewLocalSigner(privKey cmcrypto.PrivKey, stateFile string) (*LocalSigner, error) {
+	s := &LocalSigner{privKey: privKey, stateFile: stateFile}
+	if stateFile == "" {
+		return s, nil
+	}
+	bz, err := os.ReadFile(stateFile) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := cmtjson.Unmarshal(bz, &s.state); err != nil {
+		return nil, fmt.Errorf("failed to read sign state file %s: %w", stateFile, err)
+	}
+	return s, nil
+}
+
+// GetPubKey returns the public key of the signer.
+func (s *LocalSigner) GetPubKey() (cmcrypto.PubKey, error) {
+	return s.privKey.PubKey(), nil
+}
+
+// LastSignedHeight returns the height of the last signed header.
+func (s *LocalSigner) LastSignedHeight() int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.state.Height
+}
+
+// SignVote signs the precommit vote for a header, unless it conflicts with the last signed
+// header. The vote extension is always signed, as extensions are non-deterministic.
+func (s *LocalSigner) SignVote(chainID string, vote *cmproto.Vote) error {
+	if vote.Type != cmproto.PrecommitType {
+		return errors.New("only precommit votes can be signed")
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	extSig, err := s.privKey.Sign(cmtypes.VoteExtensionSignBytes(chainID, vote))
+	if err != nil {
+		return err
+	}
+	signBytes := cmtypes.VoteSignBytes(chainID, vote)
+	switch {
+	case vote.Height < s.state.Height:
+		return fmt.Errorf("%w: got %d, last signed height %d", ErrHeightRegression, vote.Height, s.state.Height)
+	case vote.Height == s.state.Height && s.state.SignBytes != nil:
+		if !bytes.Equal(signBytes, s.state.SignBytes) {
+			return fmt.Errorf("%w: another header was signed at height %d", ErrConflictingData, vote.Height)
+		}
+		vote.Signature = s.state.Signature
+		vote.ExtensionSignature = extSig
+		return nil
+	}
+
+	sig, err := s.privKey.Sign(signBytes)
+	if err != nil {
+		return err
+	}
+	// the state is saved before the signature is released
+	state := privval.FilePVLastSignState{
+		Height:    vote.Height,
+		Round:     vote.Round,
+		Step:      stepPrecommit,
+		Signature: sig,
+		SignBytes: signBytes,
+	}
+	if err := s.saveState(state); err != nil {
+		return err
+	}
+	s.state = state
+	vote.Signature = sig
+	vote.ExtensionSignature = extSig
+	return nil
+}
+
+func (s *LocalSigner) saveState(state privval.FilePVLastSignState) error {
+	if s.stateFile == "" {
+		return nil
+	}
+	bz, err := cmtjson.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(s.stateFile, bz, 0o600); err != nil {
+		return fmt.Errorf("failed to save sign state file %s: %w", s.stateFile, err)
+	}
+	return nil
+}
+
+// ResetStateFile lowers the last signed height saved in the state file of LocalSigner to given
+// height, so that headers above it are signed again. It does nothing if the file doesn't exist or
+// its height isn't above the given one. Headers above the height must never have been published,
+// as signing different headers at their heights is double signing.
+func ResetStateFile(stateFile string, height int64) error {
+	s, err := NewLocalSigner(nil, stateFile)
+	if err != nil {
+		return err
+	}
+	if s.state.Height <= height {
+		return nil
+	}
+	return s.saveState(privval.FilePVLastSignState{Height: height, Step: stepPrecommit})
+}
@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/privval"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "test-chain"
+
+func testVote(height int64, hash byte) *cmproto.Vote {
+	return &cmproto.Vote{
+		Type:      cmproto.PrecommitType,
+		Height:    height,
+		BlockID:   cmproto.BlockID{Hash: bytes.Repeat([]byte{hash}, 32)},
+		Timestamp: time.Unix(0, 0).UTC(),
+	}
+}
+
+func TestLocalSigner(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	privKey := secp256k1.GenPrivKey()
+	s, err := NewLocalSigner(privKey, stateFile)
+	require.NoError(t, err)
+	pubKey, err := s.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	vote := testVote(2, 1)
+	vote.Extension = []byte("extension")
+	require.NoError(t, s.SignVote(testChainID, vote))
+	assert.True(t, pubKey.VerifySignature(cmtypes.VoteSignBytes(testChainID, vote), vote.Signature))
+	assert.True(t, pubKey.VerifySignature(cmtypes.VoteExtensionSignBytes(testChainID, vote), vote.ExtensionSignature))
+	assert.Equal(t, int64(2), s.LastSignedHeight())
+
+	// the same header can be signed again
+	again := testVote(2, 1)
+	require.NoError(t, s.SignVote(testChainID, again))
+	assert.Equal(t, vote.Signature, again.Signature)
+
+	assert.ErrorIs(t, s.SignVote(testChainID, testVote(2, 2)), ErrConflictingData)
+	assert.ErrorIs(t, s.SignVote(testChainID, testVote(1, 1)), ErrHeightRegression)
+	prevote := testVote(3, 1)
+	prevote.Type = cmproto.PrevoteType
+	assert.Error(t, s.SignVote(testChainID, prevote))
+
+	// the last signed header is persisted
+	s, err = NewLocalSigner(privKey, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), s.LastSignedHeight())
+	assert.ErrorIs(t, s.SignVote(testChainID, testVote(2, 2)), ErrConflictingData)
+	require.NoError(t, s.SignVote(testChainID, testVote(3, 1)))
+
+	// in memory state
+	s, err = NewLocalSigner(privKey, "")
+	require.NoError(t, err)
+	require.NoError(t, s.SignVote(testChainID, testVote(1, 1)))
+	assert.ErrorIs(t, s.SignVote(testChainID, testVote(1, 2)), ErrConflictingData)
+}
+
+func TestLocalSignerCometBFTStateFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	stateFile := filepath.Join(dir, "priv_validator_state.json")
+	pv := privval.GenFilePV(keyFile, stateFile)
+	pv.Save()
+
+	s, err := NewLocalSigner(pv.Key.PrivKey, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), s.LastSignedHeight())
+	require.NoError(t, s.SignVote(testChainID, testVote(5, 1)))
+
+	// the state file saved by the signer is readable by CometBFT
+	pv = privval.LoadFilePV(keyFile, stateFile)
+	assert.Equal(t, int64(5), pv.LastSignState.Height)
+	assert.Error(t, pv.SignVote(testChainID, testVote(5, 2)))
+}
+
+func TestResetStateFile(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	stateFile := filepath.Join(t.TempDir(), "priv_validator_state.json")
+	// missing state file
+	require.NoError(t, ResetStateFile(stateFile, 1))
+
+	s, err := NewLocalSigner(privKey, stateFile)
+	require.NoError(t, err)
+	require.NoError(t, s.SignVote(testChainID, testVote(5, 1)))
+
+	require.NoError(t, ResetStateFile(stateFile, 6))
+	s, err = NewLocalSigner(privKey, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), s.LastSignedHeight())
+
+	require.NoError(t, ResetStateFile(stateFile, 3))
+	s, err = NewLocalSigner(privKey, stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), s.LastSignedHeight())
+	assert.ErrorIs(t, s.SignVote(testChainID, testVote(2, 1)), ErrHeightRegression)
+	require.NoError(t, s.SignVote(testChainID, testVote(4, 2)))
+}
@@ -0,0 +1,56 @@
+// Package signer provides the signers of block headers produced by the sequencer, holding the
+// signing key locally or using a remote signer.
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+// Signer signs block headers of the sequencer.
+//
+// Headers are signed as CometBFT precommit votes for the header hash (see
+// types.Header.AttestationVote), along with the vote extension of the block. As a result, CometBFT
+// private validators, including the ones using remote signers, are signers.
+type Signer interface {
+	// GetPubKey returns the public key of the signer.
+	GetPubKey() (cmcrypto.PubKey, error)
+
+	// SignVote signs the precommit vote, setting its signature and the signature of its
+	// extension.
+	SignVote(chainID string, vote *cmproto.Vote) error
+}
+
+var (
+	// ErrHeightRegression is returned when asked to sign a header below the last signed height.
+	ErrHeightRegression = errors.New("height regression")
+
+	// ErrConflictingData is returned when asked to sign a header different from the one already
+	// signed at the same height.
+	ErrConflictingData = errors.New("conflicting data")
+)
+
+// NewRemoteSigner listens on addr (tcp:// or unix:// address) for a remote signer using the
+// CometBFT private validator protocol, like tmkms or horcrux, and waits until it connects.
+// Double signing protection is the job of the remote signer.
+func NewRemoteSigner(addr, chainID string, timeout time.Duration, logger log.Logger) (*privval.SignerClient, error) {
+	listener, err := privval.NewSignerListener(addr, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for remote signer: %w", err)
+	}
+	client, err := privval.NewSignerClient(listener, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.WaitForConnection(timeout); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("remote signer didn't connect: %w", err)
+	}
+	return client, nil
+}
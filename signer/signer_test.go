@@ -0,0 +1,64 @@
+package signer
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	logger := log.TestingLogger()
+
+	// in-process remote signer, dialing the node like tmkms
+	privKey := ed25519.GenPrivKey()
+	local, err := NewLocalSigner(privKey, "")
+	require.NoError(t, err)
+	endpoint := privval.NewSignerDialerEndpoint(logger, privval.DialUnixFn(socket),
+		privval.SignerDialerEndpointConnRetries(50), privval.SignerDialerEndpointTimeoutReadWrite(time.Second))
+	server := privval.NewSignerServer(endpoint, testChainID, &privValidator{local})
+	// the signer dials the node until it listens
+	require.NoError(t, server.Start())
+	t.Cleanup(func() { _ = server.Stop() })
+
+	s, err := NewRemoteSigner("unix://"+socket, testChainID, 5*time.Second, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	pubKey, err := s.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	vote := testVote(1, 1)
+	vote.Extension = []byte("extension")
+	require.NoError(t, s.SignVote(testChainID, vote))
+	assert.True(t, pubKey.VerifySignature(cmtypes.VoteSignBytes(testChainID, vote), vote.Signature))
+	assert.True(t, pubKey.VerifySignature(cmtypes.VoteExtensionSignBytes(testChainID, vote), vote.ExtensionSignature))
+
+	// double signing protection of the remote signer is reported
+	assert.ErrorContains(t, s.SignVote(testChainID, testVote(1, 2)), ErrConflictingData.Error())
+}
+
+func TestRemoteSignerTimeout(t *testing.T) {
+	addr := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	_, err := NewRemoteSigner(addr, testChainID, 100*time.Millisecond, log.TestingLogger())
+	assert.ErrorContains(t, err, "remote signer didn't connect")
+}
+
+// privValidator is a CometBFT private validator signing votes with a signer.
+type privValidator struct {
+	Signer
+}
+
+func (privValidator) SignProposal(string, *cmproto.Proposal) error {
+	return errors.New("proposals can't be signed")
+}
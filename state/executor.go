@@ -8,6 +8,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
@@ -25,6 +26,10 @@ var ErrEmptyValSetGenerated = errors.New("applying the validator changes would r
 // ErrAddingValidatorToBased is returned when trying to add a validator to an empty validator set.
 var ErrAddingValidatorToBased = errors.New("cannot add validators to empty validator set")
 
+// ErrFinalizeBlock is returned when the application fails to execute a block, e.g. because the
+// binary has to be upgraded at the height of the block.
+var ErrFinalizeBlock = errors.New("application failed to finalize block")
+
 // BlockExecutor creates and applies blocks and maintains state.
 type BlockExecutor struct {
 	proposerAddress []byte
@@ -33,6 +38,7 @@ type BlockExecutor struct {
 	mempool         mempool.Mempool
 	mempoolReaper   *mempool.CListMempoolReaper
 	maxBytes        uint64
+	isrEnabled      bool
 
 	eventBus *cmtypes.EventBus
 
@@ -42,7 +48,7 @@ type BlockExecutor struct {
 }
 
 // NewBlockExecutor creates new instance of BlockExecutor.
-func NewBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Mempool, mempoolReaper *mempool.CListMempoolReaper, proxyApp proxy.AppConnConsensus, eventBus *cmtypes.EventBus, maxBytes uint64, logger log.Logger, metrics *Metrics) *BlockExecutor {
+func NewBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Mempool, mempoolReaper *mempool.CListMempoolReaper, proxyApp proxy.AppConnConsensus, eventBus *cmtypes.EventBus, maxBytes uint64, isrEnabled bool, logger log.Logger, metrics *Metrics) *BlockExecutor {
 	return &BlockExecutor{
 		proposerAddress: proposerAddress,
 		chainID:         chainID,
@@ -51,6 +57,7 @@ func NewBlockExecutor(proposerAddress []byte, chainID string, mempool mempool.Me
 		mempoolReaper:   mempoolReaper,
 		eventBus:        eventBus,
 		maxBytes:        maxBytes,
+		isrEnabled:      isrEnabled,
 		logger:          logger,
 		metrics:         metrics,
 	}
@@ -105,6 +112,13 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastSignature *types.Signatur
 		e.logger.Debug("limiting maxBytes to", "e.maxBytes=%d", e.maxBytes)
 		maxBytes = int64(e.maxBytes) //nolint:gosec
 	}
+	if e.isrEnabled {
+		// intermediate state roots are added to the block after execution
+		maxBytes -= e.isrSize(state, len(txs))
+		if maxBytes <= 0 {
+			return nil, nil, errors.New("no space left for transactions after intermediate state roots")
+		}
+	}
 
 	header := &types.SignedHeader{
 		Header: types.Header{
@@ -127,7 +141,6 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastSignature *types.Signatur
 	}
 	data := &types.Data{
 		Txs: toRollkitTxs(txs),
-		// IntermediateStateRoots: types.IntermediateStateRoots{RawRootsList: nil},
 		// Note: Temporarily remove Evidence #896
 		// Evidence:               types.EvidenceData{Evidence: nil},
 	}
@@ -158,6 +171,10 @@ func (e *BlockExecutor) CreateBlock(height uint64, lastSignature *types.Signatur
 	}
 
 	txl := cmtypes.ToTxs(rpp.Txs)
+	if e.isrEnabled && len(txl) > len(txs) {
+		// the application added transactions, each of them needs an intermediate state root
+		maxBytes -= e.isrSize(state, len(txl)) - e.isrSize(state, len(txs))
+	}
 	if err := txl.Validate(maxBytes); err != nil {
 		return nil, nil, err
 	}
@@ -209,6 +226,17 @@ func (e *BlockExecutor) ProcessProposal(
 
 // ApplyBlock validates and executes the block.
 func (e *BlockExecutor) ApplyBlock(ctx context.Context, state types.State, header *types.SignedHeader, data *types.Data) (types.State, *abci.ResponseFinalizeBlock, error) {
+	return e.applyBlock(ctx, state, header, data, e.Validate, true)
+}
+
+// ApplyProposal validates and executes the block created by the sequencer. The header is signed
+// only after execution, as it commits to intermediate state roots, so its signature isn't verified.
+func (e *BlockExecutor) ApplyProposal(ctx context.Context, state types.State, header *types.SignedHeader, data *types.Data) (types.State, *abci.ResponseFinalizeBlock, error) {
+	return e.applyBlock(ctx, state, header, data, e.validateProposal, false)
+}
+
+func (e *BlockExecutor) applyBlock(ctx context.Context, state types.State, header *types.SignedHeader, data *types.Data,
+	validate func(types.State, *types.SignedHeader, *types.Data) error, verifyISRs bool) (types.State, *abci.ResponseFinalizeBlock, error) {
 	isAppValid, err := e.ProcessProposal(header, data, state)
 	if err != nil {
 		return types.State{}, nil, err
@@ -217,7 +245,7 @@ func (e *BlockExecutor) ApplyBlock(ctx context.Context, state types.State, heade
 		return types.State{}, nil, fmt.Errorf("proposal processing resulted in an invalid application state")
 	}
 
-	err = e.Validate(state, header, data)
+	err = validate(state, header, data)
 	if err != nil {
 		return types.State{}, nil, err
 	}
@@ -226,6 +254,11 @@ func (e *BlockExecutor) ApplyBlock(ctx context.Context, state types.State, heade
 	if err != nil {
 		return types.State{}, nil, err
 	}
+	if e.isrEnabled && verifyISRs {
+		if err := e.verifyIntermediateStateRoots(state, header, data, resp); err != nil {
+			return types.State{}, nil, err
+		}
+	}
 	abciValUpdates := resp.ValidatorUpdates
 
 	validatorUpdates, err := cmtypes.PB2TM.ValidatorUpdates(abciValUpdates)
@@ -249,6 +282,55 @@ func (e *BlockExecutor) ApplyBlock(ctx context.Context, state types.State, heade
 	return state, resp, nil
 }
 
+// ApplyBlockResponses updates the state with the responses of a block executed by another node,
+// without executing the block. The block has to be validated by the caller.
+func (e *BlockExecutor) ApplyBlockResponses(state types.State, header *types.SignedHeader, data *types.Data, resp *abci.ResponseFinalizeBlock) (types.State, error) {
+	if len(data.Txs) != len(resp.TxResults) {
+		return types.State{}, fmt.Errorf("expected tx results length to match size of transactions in block. Expected %d, got %d", len(data.Txs), len(resp.TxResults))
+	}
+	validatorUpdates, err := cmtypes.PB2TM.ValidatorUpdates(resp.ValidatorUpdates)
+	if err != nil {
+		return types.State{}, err
+	}
+	return e.updateState(state, header, data, resp, validatorUpdates)
+}
+
+// SetIntermediateStateRoots computes intermediate state roots of the executed block and
+// stores them in data. It's a no-op if intermediate state roots are disabled.
+func (e *BlockExecutor) SetIntermediateStateRoots(state types.State, data *types.Data, resp *abci.ResponseFinalizeBlock) error {
+	if !e.isrEnabled {
+		return nil
+	}
+	isrs, err := IntermediateStateRoots(state.AppHash, data.Txs, resp.TxResults)
+	if err != nil {
+		return err
+	}
+	data.IntermediateStateRoots = isrs
+	return nil
+}
+
+// isrSize returns the space needed for intermediate state roots of a block with nTxs transactions.
+// Roots are assumed to have the size of the app hash.
+func (e *BlockExecutor) isrSize(state types.State, nTxs int) int64 {
+	return IntermediateStateRootsSize(nTxs, max(len(state.AppHash), tmhash.Size))
+}
+
+func (e *BlockExecutor) verifyIntermediateStateRoots(state types.State, header *types.SignedHeader, data *types.Data, resp *abci.ResponseFinalizeBlock) error {
+	isrs, err := IntermediateStateRoots(state.AppHash, data.Txs, resp.TxResults)
+	if err != nil {
+		return err
+	}
+	proof, err := GenerateFraudProof(header, data, isrs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrISRMismatch, err)
+	}
+	if proof != nil {
+		e.logger.Error("intermediate state root mismatch", "height", header.Height(), "txIndex", proof.TxIndex)
+		return &FraudError{Proof: proof}
+	}
+	return nil
+}
+
 // ExtendVote calls the ExtendVote ABCI method on the proxy app.
 func (e *BlockExecutor) ExtendVote(ctx context.Context, header *types.SignedHeader, data *types.Data) ([]byte, error) {
 	resp, err := e.proxyApp.ExtendVote(ctx, &abci.RequestExtendVote{
@@ -390,6 +472,26 @@ func (e *BlockExecutor) Validate(state types.State, header *types.SignedHeader,
 	if err := header.ValidateBasic(); err != nil {
 		return err
 	}
+	// a sequencer omitting intermediate state roots can't be proven fraudulent
+	if e.isrEnabled && len(data.IntermediateStateRoots.RawRootsList) != len(data.Txs)+1 {
+		return fmt.Errorf("%w: expected %d roots, got %d", types.ErrMissingIntermediateStateRoots,
+			len(data.Txs)+1, len(data.IntermediateStateRoots.RawRootsList))
+	}
+	return e.validate(state, header, data)
+}
+
+// validateProposal validates the block created by the sequencer, before its header is signed.
+func (e *BlockExecutor) validateProposal(state types.State, header *types.SignedHeader, data *types.Data) error {
+	if err := header.Header.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := header.Validators.ValidateBasic(); err != nil {
+		return err
+	}
+	return e.validate(state, header, data)
+}
+
+func (e *BlockExecutor) validate(state types.State, header *types.SignedHeader, data *types.Data) error {
 	if err := data.ValidateBasic(); err != nil {
 		return err
 	}
@@ -452,7 +554,7 @@ func (e *BlockExecutor) execute(ctx context.Context, state types.State, header *
 	e.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
 	if err != nil {
 		e.logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
-		return nil, err
+		return nil, fmt.Errorf("%w: %w", ErrFinalizeBlock, err)
 	}
 
 	e.logger.Info(
@@ -56,7 +56,7 @@ func doTestCreateBlock(t *testing.T) {
 	fmt.Println("Made NID")
 	mpool := mempool.NewCListMempool(cfg.DefaultMempoolConfig(), proxy.NewAppConnMempool(client, proxy.NopMetrics()), 0)
 	fmt.Println("Made a NewTxMempool")
-	executor := NewBlockExecutor([]byte("test address"), "test", mpool, nil, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, logger, NopMetrics())
+	executor := NewBlockExecutor([]byte("test address"), "test", mpool, nil, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 100, false, logger, NopMetrics())
 	fmt.Println("Made a New Block Executor")
 
 	state := types.State{}
@@ -121,6 +121,32 @@ func TestCreateBlockWithFraudProofsDisabled(t *testing.T) {
 	doTestCreateBlock(t)
 }
 
+func TestCreateBlockWithIntermediateStateRoots(t *testing.T) {
+	app := &mocks.Application{}
+	app.On("PrepareProposal", mock.Anything, mock.Anything).Return(prepareProposalResponse)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+	executor := NewBlockExecutor([]byte("test address"), "test", nil, nil, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), nil, 1000, true, log.TestingLogger(), NopMetrics())
+
+	state := types.State{AppHash: types.GetRandomBytes(32), Validators: types.GetRandomValidatorSet()}
+	state.ConsensusParams.Block = &cmproto.BlockParams{MaxBytes: 100}
+
+	// roots of the empty block and of the transaction take 68 bytes
+	_, data, err := executor.CreateBlock(1, &types.Signature{}, abci.ExtendedCommitInfo{}, []byte{}, state, cmtypes.Txs{make([]byte, 30)})
+	require.NoError(t, err)
+	assert.Len(t, data.Txs, 1)
+	_, _, err = executor.CreateBlock(1, &types.Signature{}, abci.ExtendedCommitInfo{}, []byte{}, state, cmtypes.Txs{make([]byte, 40)})
+	assert.Error(t, err)
+
+	// a block without intermediate state roots is rejected
+	header, data := types.GetRandomBlock(1, 2)
+	state = types.State{InitialHeight: 1, AppHash: header.AppHash, LastResultsHash: header.LastResultsHash}
+	state.Version.Consensus.App = header.Version.App
+	state.Version.Consensus.Block = header.Version.Block
+	data.IntermediateStateRoots = types.IntermediateStateRoots{}
+	assert.ErrorIs(t, executor.Validate(state, header, data), types.ErrMissingIntermediateStateRoots)
+}
+
 func doTestApplyBlock(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -200,7 +226,7 @@ func doTestApplyBlock(t *testing.T) {
 	state.ConsensusParams.Block.MaxBytes = 100
 	state.ConsensusParams.Block.MaxGas = 100000
 	chainID := "test"
-	executor := NewBlockExecutor(vKey.PubKey().Address().Bytes(), chainID, mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics())
+	executor := NewBlockExecutor(vKey.PubKey().Address().Bytes(), chainID, mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, false, logger, NopMetrics())
 
 	tx := []byte{1, 2, 3, 4}
 	err = mpool.CheckTx(tx, func(r *abci.ResponseCheckTx) {}, mempool.TxInfo{})
@@ -309,7 +335,7 @@ func TestUpdateStateConsensusParams(t *testing.T) {
 	require.NoError(t, mpoolReaper.StartReaper(context.Background()))
 	eventBus := cmtypes.NewEventBus()
 	require.NoError(t, eventBus.Start())
-	executor := NewBlockExecutor([]byte("test address"), chainID, mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, logger, NopMetrics())
+	executor := NewBlockExecutor([]byte("test address"), chainID, mpool, mpoolReaper, proxy.NewAppConnConsensus(client, proxy.NopMetrics()), eventBus, 100, false, logger, NopMetrics())
 
 	state := types.State{
 		ConsensusParams: cmproto.ConsensusParams{
@@ -0,0 +1,163 @@
+package state
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+var (
+	// ErrISRMismatch is returned when intermediate state roots of a block do not match local execution.
+	ErrISRMismatch = errors.New("intermediate state roots mismatch")
+
+	// ErrFraudProofInvalid is returned when re-execution of the disputed transaction
+	// yields the intermediate state root committed to by the sequencer.
+	ErrFraudProofInvalid = errors.New("fraud proof is invalid: re-execution matches committed state root")
+
+	// ErrMissingTxStateRoot is returned when the application doesn't report the state root after
+	// execution of a transaction.
+	ErrMissingTxStateRoot = errors.New("transaction result has no intermediate state root")
+)
+
+const (
+	// ISREventType is the type of the event by which the application reports its state root after
+	// execution of a transaction. Every transaction result has to carry it when intermediate state
+	// roots are enabled.
+	ISREventType = "intermediate_state_root"
+
+	// ISREventRootKey is the attribute of the ISREventType event holding the hex encoded state root.
+	ISREventRootKey = "root"
+)
+
+// TxReplayer re-executes a single transaction on top of the application state
+// identified by an intermediate state root.
+//
+// Implementations are expected to restore the application state that preStateRoot
+// commits to (e.g. from a snapshot of the previous height), and execute tx in the
+// context of the block at the given height. The returned result has to report the
+// resulting state root (see TxStateRoot).
+type TxReplayer interface {
+	ReplayTx(ctx context.Context, height uint64, preStateRoot []byte, tx types.Tx) (*abci.ExecTxResult, error)
+}
+
+// FraudError is returned by ApplyBlock when the intermediate state roots of a block
+// do not match the ones produced by local execution. It carries the fraud proof.
+type FraudError struct {
+	Proof *types.FraudProof
+}
+
+// Error implements error interface.
+func (e *FraudError) Error() string {
+	return fmt.Sprintf("%s: height %d, tx index %d", ErrISRMismatch, e.Proof.SignedHeader.Height(), e.Proof.TxIndex)
+}
+
+// Unwrap returns ErrISRMismatch, so FraudError can be matched with errors.Is.
+func (e *FraudError) Unwrap() error {
+	return ErrISRMismatch
+}
+
+// TxStateRoot returns the application state root after execution of the transaction,
+// reported by the application in the ISREventType event of the transaction result.
+func TxStateRoot(result *abci.ExecTxResult) ([]byte, error) {
+	for _, event := range result.Events {
+		if event.Type != ISREventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != ISREventRootKey {
+				continue
+			}
+			root, err := hex.DecodeString(attr.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid intermediate state root %q: %w", attr.Value, err)
+			}
+			if len(root) == 0 {
+				break
+			}
+			return root, nil
+		}
+	}
+	return nil, ErrMissingTxStateRoot
+}
+
+// IntermediateStateRoots collects the intermediate state roots of a block from the results of
+// its transactions. The first root is the pre-state root of the block (app hash of previous
+// block), so the returned list contains len(txs)+1 roots.
+func IntermediateStateRoots(preStateRoot []byte, txs types.Txs, results []*abci.ExecTxResult) (types.IntermediateStateRoots, error) {
+	if len(txs) != len(results) {
+		return types.IntermediateStateRoots{}, fmt.Errorf("expected %d tx results, got %d", len(txs), len(results))
+	}
+	roots := make([][]byte, len(txs)+1)
+	roots[0] = preStateRoot
+	for i, result := range results {
+		root, err := TxStateRoot(result)
+		if err != nil {
+			return types.IntermediateStateRoots{}, fmt.Errorf("tx %d: %w", i, err)
+		}
+		roots[i+1] = root
+	}
+	return types.IntermediateStateRoots{RawRootsList: roots}, nil
+}
+
+// IntermediateStateRootsSize returns the upper bound of the encoded size of intermediate state
+// roots of a block with nTxs transactions, with roots of rootSize bytes.
+func IntermediateStateRootsSize(nTxs int, rootSize int) int64 {
+	// each root is encoded as a length-delimited protobuf field
+	perRoot := rootSize + 1 + binary.PutUvarint(make([]byte, binary.MaxVarintLen64), uint64(rootSize)) //nolint:gosec
+	return int64(nTxs+1) * int64(perRoot)
+}
+
+// GenerateFraudProof compares the intermediate state roots committed to in the block
+// with the locally computed ones, and returns a fraud proof for the first transaction
+// that produced a different state root.
+func GenerateFraudProof(header *types.SignedHeader, data *types.Data, computed types.IntermediateStateRoots) (*types.FraudProof, error) {
+	committed := data.IntermediateStateRoots.RawRootsList
+	if len(committed) != len(computed.RawRootsList) {
+		return nil, fmt.Errorf("invalid length of ISR list: %d, expected length: %d", len(committed), len(computed.RawRootsList))
+	}
+	if len(committed) == 0 {
+		return nil, types.ErrMissingIntermediateStateRoots
+	}
+	if !bytes.Equal(committed[0], computed.RawRootsList[0]) {
+		return nil, errors.New("pre-state root of the block does not match the last app hash")
+	}
+	for i := 1; i < len(committed); i++ {
+		if !bytes.Equal(committed[i], computed.RawRootsList[i]) {
+			return &types.FraudProof{
+				SignedHeader: header,
+				Data:         data,
+				TxIndex:      uint64(i - 1), //nolint:gosec
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// VerifyFraudProof checks the fraud proof by re-executing the disputed transaction.
+// It returns nil if the proof is valid, i.e. the sequencer committed to an invalid state root.
+func VerifyFraudProof(ctx context.Context, proof *types.FraudProof, replayer TxReplayer) error {
+	if err := proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid fraud proof: %w", err)
+	}
+	preStateRoot := proof.PreStateRoot()
+	tx := proof.Tx()
+	result, err := replayer.ReplayTx(ctx, proof.SignedHeader.Height(), preStateRoot, tx)
+	if err != nil {
+		return fmt.Errorf("failed to re-execute transaction: %w", err)
+	}
+	postStateRoot, err := TxStateRoot(result)
+	if err != nil {
+		return fmt.Errorf("failed to re-execute transaction: %w", err)
+	}
+	if bytes.Equal(postStateRoot, proof.PostStateRoot()) {
+		return ErrFraudProofInvalid
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+type mockTxReplayer struct {
+	results map[string]*abci.ExecTxResult
+}
+
+func (r *mockTxReplayer) ReplayTx(_ context.Context, _ uint64, _ []byte, tx types.Tx) (*abci.ExecTxResult, error) {
+	return r.results[string(tx)], nil
+}
+
+func resultWithRoot(root []byte) *abci.ExecTxResult {
+	return &abci.ExecTxResult{
+		Code: abci.CodeTypeOK,
+		Events: []abci.Event{{
+			Type:       ISREventType,
+			Attributes: []abci.EventAttribute{{Key: ISREventRootKey, Value: hex.EncodeToString(root)}},
+		}},
+	}
+}
+
+func getFraudulentBlock(t *testing.T, nTxs int, fraudIndex int) (*types.SignedHeader, *types.Data, types.IntermediateStateRoots, *mockTxReplayer) {
+	require := require.New(t)
+
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: nTxs})
+	replayer := &mockTxReplayer{results: make(map[string]*abci.ExecTxResult)}
+	results := make([]*abci.ExecTxResult, nTxs)
+	for i, tx := range data.Txs {
+		results[i] = resultWithRoot(types.GetRandomBytes(32))
+		replayer.results[string(tx)] = results[i]
+	}
+	isrs, err := IntermediateStateRoots(header.AppHash, data.Txs, results)
+	require.NoError(err)
+
+	committed := make([][]byte, len(isrs.RawRootsList))
+	copy(committed, isrs.RawRootsList)
+	if fraudIndex >= 0 {
+		committed[fraudIndex+1] = types.GetRandomBytes(32)
+	}
+	data.IntermediateStateRoots = types.IntermediateStateRoots{RawRootsList: committed}
+
+	header.DataHash = (&types.Data{Txs: data.Txs, IntermediateStateRoots: data.IntermediateStateRoots}).Hash()
+	signature, err := types.GetSignature(header.Header, privKey)
+	require.NoError(err)
+	header.Signature = *signature
+
+	return header, data, isrs, replayer
+}
+
+func TestIntermediateStateRoots(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	preStateRoot := types.GetRandomBytes(32)
+	roots := [][]byte{types.GetRandomBytes(32), types.GetRandomBytes(32)}
+	txs := types.Txs{types.GetRandomTx(), types.GetRandomTx()}
+	results := []*abci.ExecTxResult{resultWithRoot(roots[0]), resultWithRoot(roots[1])}
+
+	isrs, err := IntermediateStateRoots(preStateRoot, txs, results)
+	require.NoError(err)
+	assert.Equal([][]byte{preStateRoot, roots[0], roots[1]}, isrs.RawRootsList)
+
+	_, err = IntermediateStateRoots(preStateRoot, txs, results[:1])
+	assert.Error(err)
+
+	// the application has to report the state root after every transaction
+	results[1] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+	_, err = IntermediateStateRoots(preStateRoot, txs, results)
+	assert.ErrorIs(err, ErrMissingTxStateRoot)
+
+	results[1] = resultWithRoot(nil)
+	results[1].Events[0].Attributes[0].Value = "not hex"
+	_, err = IntermediateStateRoots(preStateRoot, txs, results)
+	assert.Error(err)
+}
+
+func TestIntermediateStateRootsSize(t *testing.T) {
+	isrs := types.IntermediateStateRoots{RawRootsList: [][]byte{
+		types.GetRandomBytes(32), types.GetRandomBytes(32), types.GetRandomBytes(32),
+	}}
+	encoded := (&types.Data{IntermediateStateRoots: isrs}).ToProto()
+	assert.Equal(t, int64(encoded.Size()), IntermediateStateRootsSize(2, 32))
+}
+
+func TestGenerateAndVerifyFraudProof(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	header, data, computed, replayer := getFraudulentBlock(t, 4, 2)
+
+	proof, err := GenerateFraudProof(header, data, computed)
+	require.NoError(err)
+	require.NotNil(proof)
+	assert.Equal(uint64(2), proof.TxIndex)
+	assert.NoError(VerifyFraudProof(context.Background(), proof, replayer))
+
+	// proof survives serialization
+	bz, err := proof.MarshalBinary()
+	require.NoError(err)
+	decoded := new(types.FraudProof)
+	require.NoError(decoded.UnmarshalBinary(bz))
+	assert.NoError(VerifyFraudProof(context.Background(), decoded, replayer))
+
+	// pointing at an honestly executed transaction doesn't prove anything
+	proof.TxIndex = 1
+	assert.ErrorIs(VerifyFraudProof(context.Background(), proof, replayer), ErrFraudProofInvalid)
+
+	proof.TxIndex = 4
+	assert.ErrorIs(VerifyFraudProof(context.Background(), proof, replayer), types.ErrInvalidTxIndex)
+}
+
+func TestGenerateFraudProofHonestBlock(t *testing.T) {
+	require := require.New(t)
+
+	header, data, computed, _ := getFraudulentBlock(t, 3, -1)
+	proof, err := GenerateFraudProof(header, data, computed)
+	require.NoError(err)
+	require.Nil(proof)
+}
+
+func TestVerifyFraudProofTamperedData(t *testing.T) {
+	require := require.New(t)
+
+	header, data, computed, replayer := getFraudulentBlock(t, 3, 0)
+	proof, err := GenerateFraudProof(header, data, computed)
+	require.NoError(err)
+	require.NotNil(proof)
+
+	// ISRs not committed to by the sequencer are rejected
+	data.IntermediateStateRoots.RawRootsList[1] = computed.RawRootsList[1]
+	require.Error(VerifyFraudProof(context.Background(), proof, replayer))
+}
@@ -19,4 +19,7 @@ type BlockIndexer interface {
 	// Search performs a query for block heights that match a given BeginBlock
 	// and Endblock event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
+
+	// SearchPage performs a query for a page of block heights (see Search), ordered by height.
+	SearchPage(ctx context.Context, q *query.Query, opts SearchOptions) ([]int64, PageInfo, error)
 }
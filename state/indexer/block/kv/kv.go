@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 
 	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
@@ -242,6 +244,58 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	return results, nil
 }
 
+// SearchPage performs a query for block heights (see Search), returning a page of them according to opts.
+// If the page is requested by a cursor (see SearchOptions.Scannable) and the query consists of event
+// equalities and height conditions, only heights from the cursor to the end of the page are scanned.
+// Pages requested by an offset still match all results, as they are counted.
+func (idx *BlockerIndexer) SearchPage(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]int64, indexer.PageInfo, error) {
+	if opts.Scannable() {
+		if events, lo, hi, ok := indexer.ScanConditions(q.Syntax(), types.BlockHeightKey); ok {
+			return indexer.ScanPage(ctx, opts, lo, hi, func(height int64) ([]int64, error) {
+				return idx.matchHeight(ctx, events, height)
+			}, heightPosition)
+		}
+	}
+
+	heights, err := idx.Search(ctx, q)
+	if err != nil {
+		return nil, indexer.PageInfo{}, err
+	}
+	page, info := indexer.Paginate(heights, heightPosition, opts)
+	return page, info, nil
+}
+
+// matchHeight returns the height if the block at it is indexed and has events matching all the
+// equality conditions, which are checked by the prefixes of event keys.
+func (idx *BlockerIndexer) matchHeight(ctx context.Context, events []syntax.Condition, height int64) ([]int64, error) {
+	if len(events) == 0 {
+		ok, err := idx.Has(height)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return []int64{height}, nil
+	}
+	for _, c := range events {
+		prefix := store.GenerateKey([]string{c.Tag, c.Arg.Value(), strconv.FormatInt(height, 10)})
+		results, err := idx.store.Query(ctx, dsq.Query{Prefix: prefix, KeysOnly: true, Limit: 1})
+		if err != nil {
+			return nil, err
+		}
+		entries, err := results.Rest()
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			return nil, nil
+		}
+	}
+	return []int64{height}, nil
+}
+
+func heightPosition(height int64) indexer.Position {
+	return indexer.Position{Height: height}
+}
+
 // matchRange returns all matching block heights that match a given QueryRange
 // and start key. An already filtered result (filteredHeights) is provided such
 // that any non-intersecting matches are removed.
@@ -12,6 +12,7 @@ import (
 	ktds "github.com/ipfs/go-datastore/keytransform"
 	"github.com/stretchr/testify/require"
 
+	"github.com/rollkit/rollkit/state/indexer"
 	blockidxkv "github.com/rollkit/rollkit/state/indexer/block/kv"
 	"github.com/rollkit/rollkit/store"
 )
@@ -144,3 +145,44 @@ func TestBlockIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockSearchPage(t *testing.T) {
+	kvStore, err := store.NewDefaultInMemoryKVStore()
+	require.NoError(t, err)
+	idx := blockidxkv.New(context.Background(), kvStore)
+
+	for h := int64(1); h <= 10; h++ {
+		require.NoError(t, idx.Index(types.EventDataNewBlockEvents{
+			Height: h,
+			Events: []abci.Event{
+				{
+					Type: "end_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "parity", Value: []string{"even", "odd"}[h%2], Index: true},
+						{Key: "proposer", Value: "FCAA001", Index: true},
+					},
+				},
+			},
+		}))
+	}
+
+	search := func(q string, maxHeight int64) []int64 {
+		var heights []int64
+		opts := indexer.SearchOptions{Order: indexer.OrderDesc, Limit: 2, MaxHeight: maxHeight}
+		for {
+			page, info, err := idx.SearchPage(context.Background(), query.MustCompile(q), opts)
+			require.NoError(t, err)
+			heights = append(heights, page...)
+			if info.Next == nil {
+				return heights
+			}
+			opts.After = info.Next
+		}
+	}
+
+	const odd = "end_event.parity = 'odd' AND end_event.proposer = 'FCAA001'"
+	require.Equal(t, []int64{9, 7, 5, 3, 1}, search(odd, 0))
+	require.Equal(t, []int64{9, 7, 5, 3, 1}, search(odd, 10))
+	require.Equal(t, []int64{7, 5}, search(odd+" AND block.height > 4 AND block.height < 9", 10))
+	require.Equal(t, []int64{3, 1}, search(odd+" AND block.height <= 3", 10))
+}
@@ -0,0 +1,123 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Order defines the order of search results.
+type Order string
+
+const (
+	// OrderAsc orders results from the lowest height (and transaction index).
+	OrderAsc Order = "asc"
+	// OrderDesc orders results from the highest height (and transaction index).
+	OrderDesc Order = "desc"
+)
+
+// ParseOrder parses order of search results. Empty string means OrderAsc.
+func ParseOrder(order string) (Order, error) {
+	switch order {
+	case "", string(OrderAsc):
+		return OrderAsc, nil
+	case string(OrderDesc):
+		return OrderDesc, nil
+	default:
+		return "", errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+}
+
+// Position is the position of a search result: height of the block and index of
+// the transaction in the block (always 0 for blocks).
+type Position struct {
+	Height int64
+	Index  uint32
+}
+
+// Before returns true if p precedes other in ascending order.
+func (p Position) Before(other Position) bool {
+	if p.Height == other.Height {
+		return p.Index < other.Index
+	}
+	return p.Height < other.Height
+}
+
+// Cursor encodes p as a pagination cursor.
+func (p Position) Cursor() string {
+	return fmt.Sprintf("%d.%d", p.Height, p.Index)
+}
+
+// ParseCursor decodes pagination cursor created by Position.Cursor.
+func ParseCursor(cursor string) (Position, error) {
+	heightStr, indexStr, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return Position{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	index, err := strconv.ParseUint(indexStr, 10, 32)
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	return Position{Height: height, Index: uint32(index)}, nil
+}
+
+// SearchOptions define order and range of search results returned by indexers.
+type SearchOptions struct {
+	Order Order
+	// After is the position of the last result of the previous page (exclusive).
+	// If nil, results are returned from the beginning.
+	After *Position
+	// Offset is the number of results to skip (after applying After). Pages requested by an
+	// offset are not scannable, as all results are matched to count them (see PageInfo.TotalCount).
+	Offset int
+	// Limit is the maximum number of returned results. 0 means no limit.
+	Limit int
+	// MaxHeight is the highest indexed height. If set, pages requested by After and Limit only
+	// are found by scanning heights from the cursor, see Scannable.
+	MaxHeight int64
+}
+
+// PageInfo describes a page of search results.
+type PageInfo struct {
+	// TotalCount is the number of all results matching the query, or -1 if they weren't counted.
+	TotalCount int
+	// Next is the position to pass as SearchOptions.After to get the next page.
+	// It's nil if there are no more results.
+	Next *Position
+}
+
+// Paginate sorts items by their positions and returns a page of them according to opts.
+// Items are sorted in place.
+func Paginate[T any](items []T, position func(T) Position, opts SearchOptions) ([]T, PageInfo) {
+	before := func(a, b Position) bool { return a.Before(b) }
+	if opts.Order == OrderDesc {
+		before = func(a, b Position) bool { return b.Before(a) }
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return before(position(items[i]), position(items[j]))
+	})
+
+	info := PageInfo{TotalCount: len(items)}
+	start := 0
+	if opts.After != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			return before(*opts.After, position(items[i]))
+		})
+	}
+	start = min(start+max(opts.Offset, 0), len(items))
+	end := len(items)
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, len(items))
+	}
+	if end < len(items) && end > start {
+		next := position(items[end-1])
+		info.Next = &next
+	}
+	return items[start:end], info
+}
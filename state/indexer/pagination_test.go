@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	pos := Position{Height: 12, Index: 3}
+	assert.Equal("12.3", pos.Cursor())
+	parsed, err := ParseCursor(pos.Cursor())
+	require.NoError(err)
+	assert.Equal(pos, parsed)
+
+	for _, cursor := range []string{"", "12", "a.1", "1.b", "1.-1"} {
+		_, err := ParseCursor(cursor)
+		assert.Error(err, cursor)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	position := func(p Position) Position { return p }
+	items := func() []Position {
+		return []Position{{2, 1}, {1, 0}, {3, 0}, {2, 0}, {1, 1}}
+	}
+
+	cases := []struct {
+		name     string
+		opts     SearchOptions
+		expected []Position
+		next     *Position
+	}{
+		{"all", SearchOptions{}, []Position{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 0}}, nil},
+		{"all desc", SearchOptions{Order: OrderDesc}, []Position{{3, 0}, {2, 1}, {2, 0}, {1, 1}, {1, 0}}, nil},
+		{"limit", SearchOptions{Limit: 2}, []Position{{1, 0}, {1, 1}}, &Position{1, 1}},
+		{"offset", SearchOptions{Offset: 2, Limit: 2}, []Position{{2, 0}, {2, 1}}, &Position{2, 1}},
+		{"after", SearchOptions{After: &Position{1, 1}, Limit: 2}, []Position{{2, 0}, {2, 1}}, &Position{2, 1}},
+		{"after desc", SearchOptions{Order: OrderDesc, After: &Position{2, 0}, Limit: 2}, []Position{{1, 1}, {1, 0}}, nil},
+		{"after missing", SearchOptions{After: &Position{2, 5}}, []Position{{3, 0}}, nil},
+		{"last page", SearchOptions{After: &Position{2, 1}, Limit: 1}, []Position{{3, 0}}, nil},
+		{"out of range", SearchOptions{Offset: 10}, []Position{}, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			page, info := Paginate(items(), position, c.opts)
+			assert.Equal(t, c.expected, page)
+			assert.Equal(t, 5, info.TotalCount)
+			assert.Equal(t, c.next, info.Next)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package indexer
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// Scannable returns true if the page requested by opts can be found by scanning heights from the
+// cursor (see ScanPage), instead of matching and sorting all results.
+func (opts SearchOptions) Scannable() bool {
+	return opts.Offset == 0 && opts.Limit > 0 && opts.MaxHeight > 0
+}
+
+// ScanConditions splits conditions of a query for ScanPage into the equality conditions on events
+// and the bounds of heights (inclusive) given by the conditions on heightKey. It returns false if
+// the query has other conditions, which can't be checked for a single height by a key prefix.
+func ScanConditions(conditions []syntax.Condition, heightKey string) (events []syntax.Condition, lo, hi int64, ok bool) {
+	lo, hi = 1, math.MaxInt64
+	for _, c := range conditions {
+		if c.Tag != heightKey {
+			if c.Op != syntax.TEq {
+				return nil, 0, 0, false
+			}
+			events = append(events, c)
+			continue
+		}
+		v := c.Arg.Number()
+		if v == nil {
+			return nil, 0, 0, false
+		}
+		switch c.Op {
+		case syntax.TEq:
+			lo, hi = max(lo, ceil(v)), min(hi, floor(v))
+		case syntax.TGt:
+			lo = max(lo, min(floor(v), math.MaxInt64-1)+1)
+		case syntax.TGeq:
+			lo = max(lo, ceil(v))
+		case syntax.TLt:
+			hi = min(hi, max(ceil(v), 1)-1)
+		case syntax.TLeq:
+			hi = min(hi, floor(v))
+		default:
+			return nil, 0, 0, false
+		}
+	}
+	return events, lo, hi, true
+}
+
+// ScanPage returns a page of results by visiting heights from lo to hi (inclusive, and at most
+// opts.MaxHeight) in the order of opts, starting at the height of opts.After. atHeight returns all
+// results at a height, in any order. The scan stops as soon as one result more than opts.Limit is
+// found, so the results aren't counted and PageInfo.TotalCount is -1.
+func ScanPage[T any](
+	ctx context.Context,
+	opts SearchOptions,
+	lo, hi int64,
+	atHeight func(height int64) ([]T, error),
+	position func(T) Position,
+) ([]T, PageInfo, error) {
+	hi = min(hi, opts.MaxHeight)
+	start, step := lo, int64(1)
+	before := func(a, b Position) bool { return a.Before(b) }
+	if opts.Order == OrderDesc {
+		start, step = hi, -1
+		before = func(a, b Position) bool { return b.Before(a) }
+	}
+	if opts.After != nil {
+		if opts.Order == OrderDesc {
+			start = min(start, opts.After.Height)
+		} else {
+			start = max(start, opts.After.Height)
+		}
+	}
+
+	page := make([]T, 0, opts.Limit+1)
+	for height := start; height >= lo && height <= hi && len(page) <= opts.Limit; height += step {
+		if err := ctx.Err(); err != nil {
+			return nil, PageInfo{}, err
+		}
+		items, err := atHeight(height)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return before(position(items[i]), position(items[j]))
+		})
+		for _, item := range items {
+			if opts.After == nil || before(*opts.After, position(item)) {
+				page = append(page, item)
+			}
+		}
+	}
+
+	info := PageInfo{TotalCount: -1}
+	if len(page) > opts.Limit {
+		page = page[:opts.Limit]
+		next := position(page[len(page)-1])
+		info.Next = &next
+	}
+	return page, info, nil
+}
+
+// floor returns the greatest integer not greater than v, saturated to int64.
+func floor(v *big.Float) int64 {
+	i, acc := v.Int64()
+	if acc == big.Above && i != math.MinInt64 {
+		i--
+	}
+	return i
+}
+
+// ceil returns the least integer not less than v, saturated to int64.
+func ceil(v *big.Float) int64 {
+	i, acc := v.Int64()
+	if acc == big.Below && i != math.MaxInt64 {
+		i++
+	}
+	return i
+}
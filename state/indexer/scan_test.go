@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanConditions(t *testing.T) {
+	cases := []struct {
+		query  string
+		events int
+		lo, hi int64
+		ok     bool
+	}{
+		{"tx.height > 2", 0, 3, math.MaxInt64, true},
+		{"tx.height >= 2.5 AND tx.height < 7", 0, 3, 6, true},
+		{"tx.height = 4 AND account.number = 1 AND account.owner = 'Ivan'", 2, 4, 4, true},
+		{"tx.height <= 0", 0, 1, 0, true},
+		{"account.number >= 1", 0, 0, 0, false},
+		{"account.owner EXISTS", 0, 0, 0, false},
+		{"tx.height = 'a'", 0, 0, 0, false},
+	}
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			events, lo, hi, ok := ScanConditions(query.MustCompile(c.query).Syntax(), "tx.height")
+			require.Equal(t, c.ok, ok)
+			assert.Len(t, events, c.events)
+			assert.Equal(t, c.lo, lo)
+			assert.Equal(t, c.hi, hi)
+		})
+	}
+}
+
+func TestScanPage(t *testing.T) {
+	position := func(p Position) Position { return p }
+	items := map[int64][]Position{
+		1: {{1, 1}, {1, 0}},
+		2: {{2, 0}, {2, 1}},
+		3: {{3, 0}},
+	}
+
+	cases := []struct {
+		name     string
+		opts     SearchOptions
+		expected []Position
+		next     *Position
+		scanned  []int64
+	}{
+		{"limit", SearchOptions{Limit: 2}, []Position{{1, 0}, {1, 1}}, &Position{1, 1}, []int64{1, 2}},
+		{"limit desc", SearchOptions{Order: OrderDesc, Limit: 2}, []Position{{3, 0}, {2, 1}}, &Position{2, 1}, []int64{4, 3, 2}},
+		{"after", SearchOptions{After: &Position{1, 1}, Limit: 2}, []Position{{2, 0}, {2, 1}}, &Position{2, 1}, []int64{1, 2, 3}},
+		{"after desc", SearchOptions{Order: OrderDesc, After: &Position{2, 0}, Limit: 2}, []Position{{1, 1}, {1, 0}}, nil, []int64{2, 1}},
+		{"last page", SearchOptions{After: &Position{2, 1}, Limit: 1}, []Position{{3, 0}}, nil, []int64{2, 3, 4}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var scanned []int64
+			c.opts.MaxHeight = 4
+			require.True(t, c.opts.Scannable())
+			page, info, err := ScanPage(context.Background(), c.opts, 1, math.MaxInt64, func(height int64) ([]Position, error) {
+				scanned = append(scanned, height)
+				return append([]Position(nil), items[height]...), nil
+			}, position)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, page)
+			assert.Equal(t, -1, info.TotalCount)
+			assert.Equal(t, c.next, info.Next)
+			assert.Equal(t, c.scanned, scanned)
+		})
+	}
+
+	assert.False(t, SearchOptions{Limit: 2}.Scannable())
+	assert.False(t, SearchOptions{Offset: 2, Limit: 2, MaxHeight: 4}.Scannable())
+}
@@ -0,0 +1,97 @@
+package psql
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/rollkit/rollkit/state/indexer"
+	"github.com/rollkit/rollkit/state/txindex"
+)
+
+// ErrSearchNotSupported is returned by search methods of indexers backed by the psql event sink.
+// Indexed events should be queried directly from the database instead.
+var ErrSearchNotSupported = errors.New("search is not supported by the psql event sink")
+
+var (
+	_ txindex.TxIndexer    = TxIndex{}
+	_ indexer.BlockIndexer = BlockIndexer{}
+)
+
+// TxIndexer returns the transaction indexer writing to es.
+func (es *EventSink) TxIndexer() TxIndex {
+	return TxIndex{psql: es}
+}
+
+// TxIndex implements the txindex.TxIndexer interface by delegating
+// indexing operations to an underlying PostgreSQL event sink.
+type TxIndex struct{ psql *EventSink }
+
+// AddBatch indexes a batch of transactions in Postgres, as part of TxIndexer.
+func (t TxIndex) AddBatch(batch *txindex.Batch) error {
+	return t.psql.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in Postgres, as part of TxIndexer.
+func (t TxIndex) Index(txr *abci.TxResult) error {
+	return t.psql.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the indexed transaction result with the given hash, or nil if it's not indexed.
+func (t TxIndex) Get(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+	return t.psql.GetTxByHash(hash)
+}
+
+// Search is implemented to satisfy the TxIndexer interface, but it is not
+// supported by the psql event sink and reports an error for all inputs.
+func (TxIndex) Search(context.Context, *query.Query) ([]*abci.TxResult, error) {
+	return nil, ErrSearchNotSupported
+}
+
+// SearchPage is implemented to satisfy the TxIndexer interface, but it is not
+// supported by the psql event sink and reports an error for all inputs.
+func (TxIndex) SearchPage(context.Context, *query.Query, indexer.SearchOptions) ([]*abci.TxResult, indexer.PageInfo, error) {
+	return nil, indexer.PageInfo{}, ErrSearchNotSupported
+}
+
+// Close closes the underlying event sink, shared with the BlockIndexer.
+func (t TxIndex) Close() error {
+	return t.psql.Stop()
+}
+
+// BlockIndexer returns the block indexer writing to es.
+func (es *EventSink) BlockIndexer() BlockIndexer {
+	return BlockIndexer{psql: es}
+}
+
+// BlockIndexer implements the indexer.BlockIndexer interface by
+// delegating indexing operations to an underlying PostgreSQL event sink.
+type BlockIndexer struct{ psql *EventSink }
+
+// Has returns true if the block at the given height is indexed.
+func (b BlockIndexer) Has(height int64) (bool, error) {
+	return b.psql.HasBlock(height)
+}
+
+// Index indexes events of the specified block. It is part of the BlockIndexer interface.
+func (b BlockIndexer) Index(block types.EventDataNewBlockEvents) error {
+	return b.psql.IndexBlockEvents(block)
+}
+
+// Search is implemented to satisfy the BlockIndexer interface, but it is not
+// supported by the psql event sink and reports an error for all inputs.
+func (BlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
+	return nil, ErrSearchNotSupported
+}
+
+// SearchPage is implemented to satisfy the BlockIndexer interface, but it is not
+// supported by the psql event sink and reports an error for all inputs.
+func (BlockIndexer) SearchPage(context.Context, *query.Query, indexer.SearchOptions) ([]int64, indexer.PageInfo, error) {
+	return nil, indexer.PageInfo{}, ErrSearchNotSupported
+}
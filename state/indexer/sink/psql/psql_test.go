@@ -0,0 +1,152 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/state/indexer"
+	"github.com/rollkit/rollkit/state/txindex"
+)
+
+const chainID = "test-chain"
+
+func newTestSink(t *testing.T) (*EventSink, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+	})
+	return newEventSink(db, chainID), mock
+}
+
+func idRows(id int) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"rowid"}).AddRow(id)
+}
+
+func expectEvent(mock sqlmock.Sqlmock, blockID, txID interface{}, eventID int, typ, key, value string) {
+	mock.ExpectQuery("INSERT INTO events").WithArgs(blockID, txID, typ).WillReturnRows(idRows(eventID))
+	mock.ExpectExec("INSERT INTO attributes").WithArgs(eventID, key, typ+"."+key, value).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestIndexBlockEvents(t *testing.T) {
+	require := require.New(t)
+	sink, mock := newTestSink(t)
+	blockIndexer := sink.BlockIndexer()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO blocks").WithArgs(5, chainID, sqlmock.AnyArg()).WillReturnRows(idRows(1))
+	expectEvent(mock, 1, nil, 10, "block", "height", "5")
+	expectEvent(mock, 1, nil, 11, "begin_event", "proposer", "FCAA001")
+	mock.ExpectCommit()
+
+	require.NoError(blockIndexer.Index(types.EventDataNewBlockEvents{
+		Height: 5,
+		Events: []abci.Event{
+			{Type: "begin_event", Attributes: []abci.EventAttribute{
+				{Key: "proposer", Value: "FCAA001", Index: true},
+				{Key: "ignored", Value: "not indexed", Index: false},
+			}},
+			{Type: ""},
+		},
+	}))
+
+	// block already indexed
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO blocks").WithArgs(5, chainID, sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"rowid"}))
+	mock.ExpectCommit()
+	require.NoError(blockIndexer.Index(types.EventDataNewBlockEvents{Height: 5}))
+
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(5, chainID).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	has, err := blockIndexer.Has(5)
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(mock.ExpectationsWereMet())
+}
+
+func TestIndexTxEvents(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	sink, mock := newTestSink(t)
+	txIndexer := sink.TxIndexer()
+
+	txResult := &abci.TxResult{
+		Height: 5,
+		Index:  0,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{
+			Code: abci.CodeTypeOK,
+			Events: []abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{{Key: "owner", Value: "Ivan", Index: true}}},
+			},
+		},
+	}
+	hash := types.Tx(txResult.Tx).Hash()
+	hashString := fmt.Sprintf("%X", hash)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT rowid FROM blocks").WithArgs(5, chainID).WillReturnRows(idRows(1))
+	mock.ExpectQuery("INSERT INTO tx_results").WithArgs(1, 0, sqlmock.AnyArg(), hashString, sqlmock.AnyArg()).
+		WillReturnRows(idRows(3))
+	expectEvent(mock, 1, 3, 20, "tx", "hash", hashString)
+	expectEvent(mock, 1, 3, 21, "tx", "height", "5")
+	expectEvent(mock, 1, 3, 22, "account", "owner", "Ivan")
+	mock.ExpectCommit()
+
+	batch := txindex.NewBatch(1)
+	require.NoError(batch.Add(txResult))
+	require.NoError(txIndexer.AddBatch(batch))
+
+	// transactions of blocks not indexed yet are rejected
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT rowid FROM blocks").WithArgs(6, chainID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	assert.Error(txIndexer.Index(&abci.TxResult{Height: 6, Tx: types.Tx("tx")}))
+
+	resultData, err := proto.Marshal(txResult)
+	require.NoError(err)
+	mock.ExpectQuery("SELECT tx_result FROM tx_results").WithArgs(hashString, chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_result"}).AddRow(resultData))
+	res, err := txIndexer.Get(hash)
+	require.NoError(err)
+	require.NotNil(res)
+	assert.True(proto.Equal(txResult, res))
+
+	mock.ExpectQuery("SELECT tx_result FROM tx_results").WithArgs("AB", chainID).
+		WillReturnRows(sqlmock.NewRows([]string{"tx_result"}))
+	res, err = txIndexer.Get([]byte{0xab})
+	require.NoError(err)
+	assert.Nil(res)
+
+	_, err = txIndexer.Get(nil)
+	assert.ErrorIs(err, txindex.ErrorEmptyHash)
+
+	require.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSearchNotSupported(t *testing.T) {
+	sink, _ := newTestSink(t)
+	q := query.MustCompile("tx.height = 1")
+
+	_, err := sink.TxIndexer().Search(context.Background(), q)
+	assert.ErrorIs(t, err, ErrSearchNotSupported)
+	_, _, err = sink.TxIndexer().SearchPage(context.Background(), q, indexer.SearchOptions{})
+	assert.ErrorIs(t, err, ErrSearchNotSupported)
+	_, err = sink.BlockIndexer().Search(context.Background(), q)
+	assert.ErrorIs(t, err, ErrSearchNotSupported)
+	_, _, err = sink.BlockIndexer().SearchPage(context.Background(), q, indexer.SearchOptions{})
+	assert.ErrorIs(t, err, ErrSearchNotSupported)
+}
@@ -0,0 +1,52 @@
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proxy"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// ReplayTxQueryPath is the prefix of the ABCI query path used by ABCITxReplayer.
+//
+// The hex encoded pre-state root is appended to the path, the transaction is passed as query data
+// and the height of the block as query height. The application has to execute the transaction on
+// top of the state identified by the pre-state root, without persisting the result, and return the
+// protobuf encoded abci.ExecTxResult (with the ISREventType event) as query value.
+const ReplayTxQueryPath = "/rollkit/replay_tx/"
+
+// ABCITxReplayer is a TxReplayer re-executing transactions in the application, using ABCI queries.
+type ABCITxReplayer struct {
+	proxyApp proxy.AppConnQuery
+}
+
+var _ TxReplayer = &ABCITxReplayer{}
+
+// NewABCITxReplayer returns a TxReplayer using the query connection to the application.
+func NewABCITxReplayer(proxyApp proxy.AppConnQuery) *ABCITxReplayer {
+	return &ABCITxReplayer{proxyApp: proxyApp}
+}
+
+// ReplayTx re-executes the transaction in the application, see ReplayTxQueryPath.
+func (r *ABCITxReplayer) ReplayTx(ctx context.Context, height uint64, preStateRoot []byte, tx types.Tx) (*abci.ExecTxResult, error) {
+	resp, err := r.proxyApp.Query(ctx, &abci.RequestQuery{
+		Path:   ReplayTxQueryPath + hex.EncodeToString(preStateRoot),
+		Data:   tx,
+		Height: int64(height), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsOK() {
+		return nil, fmt.Errorf("replay query failed with code %d: %s", resp.Code, resp.Log)
+	}
+	result := new(abci.ExecTxResult)
+	if err := result.Unmarshal(resp.Value); err != nil {
+		return nil, fmt.Errorf("invalid replay result: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/test/mocks"
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestABCITxReplayer(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	preStateRoot := types.GetRandomBytes(32)
+	postStateRoot := types.GetRandomBytes(32)
+	tx := types.GetRandomTx()
+	value, err := resultWithRoot(postStateRoot).Marshal()
+	require.NoError(err)
+
+	app := &mocks.Application{}
+	app.On("Query", mock.Anything, &abci.RequestQuery{
+		Path:   ReplayTxQueryPath + hex.EncodeToString(preStateRoot),
+		Data:   tx,
+		Height: 5,
+	}).Return(&abci.ResponseQuery{Value: value}, nil)
+	app.On("Query", mock.Anything, mock.Anything).Return(&abci.ResponseQuery{Code: 1, Log: "unknown path"}, nil)
+	client, err := proxy.NewLocalClientCreator(app).NewABCIClient()
+	require.NoError(err)
+	replayer := NewABCITxReplayer(proxy.NewAppConnQuery(client, proxy.NopMetrics()))
+
+	result, err := replayer.ReplayTx(context.Background(), 5, preStateRoot, tx)
+	require.NoError(err)
+	root, err := TxStateRoot(result)
+	require.NoError(err)
+	assert.Equal(postStateRoot, root)
+
+	_, err = replayer.ReplayTx(context.Background(), 5, postStateRoot, tx)
+	assert.ErrorContains(err, "unknown path")
+}
@@ -6,6 +6,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+
+	"github.com/rollkit/rollkit/state/indexer"
 )
 
 // XXX/TODO: These types should be moved to the indexer package.
@@ -24,6 +26,9 @@ type TxIndexer interface {
 
 	// Search allows you to query for transactions.
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
+
+	// SearchPage allows you to query for a page of transactions, ordered by height and index.
+	SearchPage(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]*abci.TxResult, indexer.PageInfo, error)
 }
 
 // Batch groups together multiple Index operations to be performed at the same time.
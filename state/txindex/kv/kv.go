@@ -182,20 +182,31 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store ds.Txn
 // "tx.hash" is found, it returns tx result for it (2) for range queries it is
 // better for the client to provide both lower and upper bounds, so we are not
 // performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// and returned to the caller, in ascending order.
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results, _, err := txi.SearchPage(ctx, q, indexer.SearchOptions{Order: indexer.OrderAsc})
+	return results, err
+}
+
+// SearchPage performs a search using the given query (see Search), returning a page of results
+// according to opts. Matching index keys are scanned to find positions of transactions, but only
+// transactions within the page are loaded from the store. If the page is requested by a cursor
+// (see SearchOptions.Scannable) and the query consists of event equalities and height conditions,
+// only heights from the cursor to the end of the page are scanned. Pages requested by an offset
+// still match all results, as they are counted.
+func (txi *TxIndex) SearchPage(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]*abci.TxResult, indexer.PageInfo, error) {
 	select {
 	case <-ctx.Done():
-		return make([]*abci.TxResult, 0), nil
+		return make([]*abci.TxResult, 0), indexer.PageInfo{}, nil
 
 	default:
 	}
 
 	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
+	filteredHashes := make(map[string]txRef)
 
 	// get a list of conditions (like "tx.height > 5")
 	conditions := q.Syntax()
@@ -203,16 +214,30 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
-		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
+		return nil, indexer.PageInfo{}, fmt.Errorf("error during searching for a hash in the query: %w", err)
 	} else if ok {
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
+			return []*abci.TxResult{}, indexer.PageInfo{}, fmt.Errorf("error while retrieving the result: %w", err)
 		case res == nil:
-			return []*abci.TxResult{}, nil
+			return []*abci.TxResult{}, indexer.PageInfo{}, nil
 		default:
-			return []*abci.TxResult{res}, nil
+			results, info := indexer.Paginate([]*abci.TxResult{res}, resultPosition, opts)
+			return results, info, nil
+		}
+	}
+
+	if opts.Scannable() {
+		if events, lo, hi, ok := indexer.ScanConditions(conditions, types.TxHeightKey); ok {
+			page, info, err := indexer.ScanPage(ctx, opts, lo, hi, func(height int64) ([]txRef, error) {
+				return txi.refsAtHeight(ctx, events, height)
+			}, txRef.Position)
+			if err != nil {
+				return nil, indexer.PageInfo{}, err
+			}
+			results, err := txi.load(ctx, page)
+			return results, info, err
 		}
 	}
 
@@ -279,18 +304,75 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
-	for _, h := range filteredHashes {
+	refs := make([]txRef, 0, len(filteredHashes))
+	for _, ref := range filteredHashes {
+		if !ref.positioned {
+			res, err := txi.Get(ref.hash)
+			if err != nil {
+				return nil, indexer.PageInfo{}, fmt.Errorf("failed to get Tx{%X}: %w", ref.hash, err)
+			}
+			if res == nil {
+				continue
+			}
+			ref.position = resultPosition(res)
+		}
+		refs = append(refs, ref)
+	}
+	page, info := indexer.Paginate(refs, txRef.Position, opts)
+	results, err := txi.load(ctx, page)
+	return results, info, err
+}
 
-		res, err := txi.Get(h)
+// refsAtHeight returns references to transactions at given height matching all the event equality
+// conditions, which are checked by the prefixes of index keys.
+func (txi *TxIndex) refsAtHeight(ctx context.Context, events []syntax.Condition, height int64) ([]txRef, error) {
+	heightStr := strconv.FormatInt(height, 10)
+	prefix := startKey(types.TxHeightKey, heightStr, heightStr)
+	if len(events) > 0 {
+		prefix = startKeyForCondition(events[0], height)
+		events = events[1:]
+	}
+	results, err := store.PrefixEntries(ctx, txi.store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]txRef, 0, len(entries))
+ENTRIES_LOOP:
+	for _, entry := range entries {
+		ref := newTxRef(entry.Key, entry.Value)
+		if !ref.positioned {
+			continue
+		}
+		indexStr := strconv.FormatUint(uint64(ref.position.Index), 10)
+		for _, c := range events {
+			ok, err := txi.store.Has(ctx, ds.NewKey(startKey(c.Tag, c.Arg.Value(), heightStr, indexStr)))
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue ENTRIES_LOOP
+			}
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// load returns indexed transactions referenced by refs, in the same order.
+func (txi *TxIndex) load(ctx context.Context, refs []txRef) ([]*abci.TxResult, error) {
+	results := make([]*abci.TxResult, 0, len(refs))
+RESULTS_LOOP:
+	for _, ref := range refs {
+		res, err := txi.Get(ref.hash)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", ref.hash, err)
 		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
+		if res != nil {
 			results = append(results, res)
 		}
 		// Potentially exit early.
@@ -300,7 +382,6 @@ RESULTS_LOOP:
 		default:
 		}
 	}
-
 	return results, nil
 }
 
@@ -323,16 +404,16 @@ func (txi *TxIndex) match(
 	ctx context.Context,
 	c syntax.Condition,
 	startKeyBz string,
-	filteredHashes map[string][]byte,
+	filteredHashes map[string]txRef,
 	firstRun bool,
-) map[string][]byte {
+) map[string]txRef {
 	// A previous match was attempted but resulted in no matches, so we return
 	// no matches (assuming AND operand).
 	if !firstRun && len(filteredHashes) == 0 {
 		return filteredHashes
 	}
 
-	tmpHashes := make(map[string][]byte)
+	tmpHashes := make(map[string]txRef)
 
 	switch {
 	case c.Op == syntax.TEq:
@@ -344,7 +425,7 @@ func (txi *TxIndex) match(
 		for result := range results.Next() {
 			cont := true
 
-			tmpHashes[string(result.Entry.Value)] = result.Entry.Value
+			tmpHashes[string(result.Entry.Value)] = newTxRef(result.Entry.Key, result.Entry.Value)
 
 			// Potentially exit early.
 			select {
@@ -369,7 +450,7 @@ func (txi *TxIndex) match(
 		for result := range results.Next() {
 			cont := true
 
-			tmpHashes[string(result.Entry.Value)] = result.Entry.Value
+			tmpHashes[string(result.Entry.Value)] = newTxRef(result.Entry.Key, result.Entry.Value)
 
 			// Potentially exit early.
 			select {
@@ -400,7 +481,7 @@ func (txi *TxIndex) match(
 			}
 
 			if strings.Contains(extractValueFromKey([]byte(result.Entry.Key)), c.Arg.Value()) {
-				tmpHashes[string(result.Entry.Value)] = result.Entry.Value
+				tmpHashes[string(result.Entry.Value)] = newTxRef(result.Entry.Key, result.Entry.Value)
 			}
 
 			// Potentially exit early.
@@ -434,7 +515,7 @@ func (txi *TxIndex) match(
 	for k := range filteredHashes {
 		cont := true
 
-		if tmpHashes[k] == nil {
+		if _, ok := tmpHashes[k]; !ok {
 			delete(filteredHashes, k)
 
 			// Potentially exit early.
@@ -462,17 +543,17 @@ func (txi *TxIndex) matchRange(
 	ctx context.Context,
 	qr indexer.QueryRange,
 	startKey string,
-	filteredHashes map[string][]byte,
+	filteredHashes map[string]txRef,
 	firstRun bool,
 	heightInfo HeightInfo,
-) map[string][]byte {
+) map[string]txRef {
 	// A previous match was attempted but resulted in no matches, so we return
 	// no matches (assuming AND operand).
 	if !firstRun && len(filteredHashes) == 0 {
 		return filteredHashes
 	}
 
-	tmpHashes := make(map[string][]byte)
+	tmpHashes := make(map[string]txRef)
 
 	results, err := store.PrefixEntries(ctx, txi.store, startKey)
 	if err != nil {
@@ -524,7 +605,7 @@ LOOP:
 
 			} else {
 				if withinBounds {
-					tmpHashes[string(result.Entry.Value)] = result.Entry.Value
+					tmpHashes[string(result.Entry.Value)] = newTxRef(result.Entry.Key, result.Entry.Value)
 				}
 			}
 
@@ -564,7 +645,7 @@ LOOP:
 	for k := range filteredHashes {
 		cont := true
 
-		if tmpHashes[k] == nil {
+		if _, ok := tmpHashes[k]; !ok {
 			delete(filteredHashes, k)
 
 			// Potentially exit early.
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"slices"
+	"sort"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -18,6 +20,7 @@ import (
 	cmrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/types"
 
+	"github.com/rollkit/rollkit/state/indexer"
 	"github.com/rollkit/rollkit/state/txindex"
 	"github.com/rollkit/rollkit/store"
 )
@@ -386,3 +389,68 @@ func BenchmarkTxIndex500(b *testing.B)   { benchmarkTxIndex(500, b) }
 func BenchmarkTxIndex1000(b *testing.B)  { benchmarkTxIndex(1000, b) }
 func BenchmarkTxIndex2000(b *testing.B)  { benchmarkTxIndex(2000, b) }
 func BenchmarkTxIndex10000(b *testing.B) { benchmarkTxIndex(10000, b) }
+
+func TestTxSearchPage(t *testing.T) {
+	kvStore, _ := store.NewDefaultInMemoryKVStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txIndexer := NewTxIndex(ctx, kvStore)
+
+	var expected []indexer.Position
+	for height := int64(3); height > 0; height-- {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{{Key: "number", Value: "1", Index: true}}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx %d.%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, txIndexer.Index(txResult))
+			expected = append(expected, indexer.Position{Height: height, Index: index})
+		}
+	}
+	sort.Slice(expected, func(i, j int) bool { return expected[i].Before(expected[j]) })
+
+	for _, order := range []indexer.Order{indexer.OrderAsc, indexer.OrderDesc} {
+		if order == indexer.OrderDesc {
+			slices.Reverse(expected)
+		}
+		// without MaxHeight all matching results are sorted, otherwise heights are scanned from the cursor
+		for _, maxHeight := range []int64{0, 3} {
+			t.Run(fmt.Sprintf("%s/%d", order, maxHeight), func(t *testing.T) {
+				totalCount := len(expected)
+				if maxHeight > 0 {
+					totalCount = -1
+				}
+				var positions []indexer.Position
+				opts := indexer.SearchOptions{Order: order, Limit: 4, MaxHeight: maxHeight}
+				for {
+					results, info, err := txIndexer.SearchPage(ctx, query.MustCompile("account.number = 1"), opts)
+					require.NoError(t, err)
+					assert.Equal(t, totalCount, info.TotalCount)
+					for _, res := range results {
+						positions = append(positions, indexer.Position{Height: res.Height, Index: res.Index})
+					}
+					if info.Next == nil {
+						break
+					}
+					opts.After = info.Next
+				}
+				assert.Equal(t, expected, positions)
+			})
+		}
+	}
+
+	results, info, err := txIndexer.SearchPage(ctx, query.MustCompile("account.number = 1 AND tx.height < 3 AND tx.height > 1"),
+		indexer.SearchOptions{Order: indexer.OrderDesc, Limit: 1, MaxHeight: 3})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, indexer.Position{Height: 2, Index: 1}, indexer.Position{Height: results[0].Height, Index: results[0].Index})
+	assert.Equal(t, &indexer.Position{Height: 2, Index: 1}, info.Next)
+
+	results, err = txIndexer.Search(ctx, query.MustCompile("account.number >= 1 AND tx.height > 1"))
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	assert.Equal(t, int64(2), results[0].Height)
+	assert.Equal(t, uint32(0), results[0].Index)
+}
@@ -2,7 +2,10 @@ package kv
 
 import (
 	"math/big"
+	"strconv"
+	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtsyntax "github.com/cometbft/cometbft/libs/pubsub/query/syntax"
 	"github.com/cometbft/cometbft/types"
 
@@ -86,3 +89,41 @@ func checkHeightConditions(heightInfo HeightInfo, keyHeight int64) (bool, error)
 	}
 	return true, nil
 }
+
+// txRef references an indexed transaction by its hash, with position parsed from the index key,
+// so results can be ordered and paginated without loading them.
+type txRef struct {
+	hash     []byte
+	position indexer.Position
+	// positioned is false if position couldn't be parsed from the key
+	positioned bool
+}
+
+// newTxRef creates txRef from index key (ending with height and index of transaction) and its value.
+func newTxRef(key string, hash []byte) txRef {
+	ref := txRef{hash: hash}
+	parts := strings.Split(key, tagKeySeparator)
+	if len(parts) < 2 {
+		return ref
+	}
+	height, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return ref
+	}
+	index, err := strconv.ParseUint(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return ref
+	}
+	ref.position = indexer.Position{Height: height, Index: uint32(index)}
+	ref.positioned = true
+	return ref
+}
+
+// Position returns the position of the referenced transaction.
+func (ref txRef) Position() indexer.Position {
+	return ref.position
+}
+
+func resultPosition(res *abci.TxResult) indexer.Position {
+	return indexer.Position{Height: res.Height, Index: res.Index}
+}
@@ -7,6 +7,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 
+	"github.com/rollkit/rollkit/state/indexer"
 	"github.com/rollkit/rollkit/state/txindex"
 )
 
@@ -33,3 +34,7 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
 	return []*abci.TxResult{}, nil
 }
+
+func (txi *TxIndex) SearchPage(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]*abci.TxResult, indexer.PageInfo, error) {
+	return []*abci.TxResult{}, indexer.PageInfo{}, nil
+}
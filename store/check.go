@@ -0,0 +1,80 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+// Inconsistency is a problem with the block at Height, found by CheckConsistency.
+type Inconsistency struct {
+	Height  uint64 `json:"height"`
+	Problem string `json:"problem"`
+}
+
+// CheckConsistency checks blocks saved in the store from height from to height to (inclusive).
+// For every height, the index must map the height to a hash, and the header saved under this hash
+// must have this hash and height. Block data and signature must be saved for the header.
+// Missing index entries are reported as height gaps.
+//
+// Problems are returned in order of height. An error is returned only if the store can't be read.
+func (s *DefaultStore) CheckConsistency(ctx context.Context, from, to uint64) ([]Inconsistency, error) {
+	var problems []Inconsistency
+	report := func(height uint64, format string, args ...interface{}) {
+		problems = append(problems, Inconsistency{Height: height, Problem: fmt.Sprintf(format, args...)})
+	}
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return problems, err
+		}
+		hash, err := s.db.Get(ctx, ds.NewKey(getIndexKey(height)))
+		if errors.Is(err, ds.ErrNotFound) {
+			report(height, "height gap: block is missing in the index")
+			continue
+		}
+		if err != nil {
+			return problems, err
+		}
+		if len(hash) != 32 {
+			report(height, "index entry has invalid hash length %d", len(hash))
+			continue
+		}
+
+		headerBlob, err := s.db.Get(ctx, ds.NewKey(getHeaderKey(hash)))
+		if errors.Is(err, ds.ErrNotFound) {
+			report(height, "header %X is missing", hash)
+			continue
+		}
+		if err != nil {
+			return problems, err
+		}
+		header := new(types.SignedHeader)
+		if err := header.UnmarshalBinary(headerBlob); err != nil {
+			report(height, "header %X can't be decoded: %v", hash, err)
+			continue
+		}
+		if !bytes.Equal(header.Hash(), hash) {
+			report(height, "index maps height to hash %X, but header hash is %X", hash, header.Hash())
+		}
+		if header.Height() != height {
+			report(height, "index maps height to header at height %d", header.Height())
+		}
+
+		if has, err := s.db.Has(ctx, ds.NewKey(getDataKey(hash))); err != nil {
+			return problems, err
+		} else if !has {
+			report(height, "block data is missing")
+		}
+		if has, err := s.db.Has(ctx, ds.NewKey(getSignatureKey(hash))); err != nil {
+			return problems, err
+		} else if !has {
+			report(height, "signature is missing")
+		}
+	}
+	return problems, nil
+}
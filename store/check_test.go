@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/rollkit/types"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv).(*DefaultStore)
+
+	header, data, privKey := types.GenerateRandomBlockCustom(&types.BlockConfig{Height: 1, NTxs: 1})
+	headers := []*types.SignedHeader{header}
+	for i := 1; i <= 5; i++ {
+		require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+		header, data = types.GetRandomNextBlock(header, data, privKey, types.GetRandomBytes(32), 1)
+		headers = append(headers, header)
+	}
+
+	problems, err := s.CheckConsistency(ctx, 1, 5)
+	require.NoError(err)
+	assert.Empty(problems)
+
+	require.NoError(kv.Delete(ctx, ds.NewKey(getSignatureKey(headers[1].Hash()))))
+	require.NoError(kv.Delete(ctx, ds.NewKey(getIndexKey(3))))
+	require.NoError(kv.Put(ctx, ds.NewKey(getIndexKey(4)), headers[4].Hash()))
+
+	problems, err = s.CheckConsistency(ctx, 1, 6)
+	require.NoError(err)
+	assert.Equal([]Inconsistency{
+		{Height: 2, Problem: "signature is missing"},
+		{Height: 3, Problem: "height gap: block is missing in the index"},
+		{Height: 4, Problem: "index maps height to header at height 5"},
+		{Height: 6, Problem: "height gap: block is missing in the index"},
+	}, problems)
+}
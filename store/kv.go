@@ -30,6 +30,16 @@ func NewDefaultKVStore(rootDir, dbPath, dbName string) (ds.TxnDatastore, error)
 	return badger4.NewDatastore(path, nil)
 }
 
+// NewDefaultReadOnlyKVStore opens the default key-value store in read-only mode.
+// The store must not be used by a running node.
+func NewDefaultReadOnlyKVStore(rootDir, dbPath, dbName string) (ds.TxnDatastore, error) {
+	path := filepath.Join(rootify(rootDir, dbPath), dbName)
+	readOnlyOptions := &badger4.Options{
+		Options: badger4.DefaultOptions.WithReadOnly(true),
+	}
+	return badger4.NewDatastore(path, readOnlyOptions)
+}
+
 // PrefixEntries retrieves all entries in the datastore whose keys have the supplied prefix
 func PrefixEntries(ctx context.Context, store ds.Datastore, prefix string) (dsq.Results, error) {
 	results, err := store.Query(ctx, dsq.Query{Prefix: prefix})
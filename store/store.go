@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync/atomic"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
 
 	"github.com/celestiaorg/go-header"
 
@@ -146,6 +148,72 @@ func (s *DefaultStore) GetBlockByHash(ctx context.Context, hash types.Hash) (*ty
 	return header, data, nil
 }
 
+// DeleteBlockData removes block (header, data and signature) at given height from Store,
+// along with block responses and extended commit saved for this height.
+func (s *DefaultStore) DeleteBlockData(ctx context.Context, height uint64) error {
+	hash, err := s.loadHashFromIndex(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to load hash from index: %w", err)
+	}
+
+	bb, err := s.db.NewTransaction(ctx, false)
+	if err != nil {
+		return fmt.Errorf("failed to create a new batch for transaction: %w", err)
+	}
+	defer bb.Discard(ctx)
+
+	keys := []string{
+		getHeaderKey(hash),
+		getDataKey(hash),
+		getSignatureKey(hash),
+		getIndexKey(height),
+		getResponsesKey(height),
+		getExtendedCommitKey(height),
+	}
+	for _, key := range keys {
+		if err := bb.Delete(ctx, ds.NewKey(key)); err != nil {
+			return fmt.Errorf("failed to delete key %s: %w", key, err)
+		}
+	}
+
+	if err = bb.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Rollback removes all blocks above given height and sets height of the Store to given height.
+// Blocks saved above the height of the Store (e.g. left by an interrupted rollback) are removed too.
+// State is not modified by Rollback.
+func (s *DefaultStore) Rollback(ctx context.Context, height uint64) error {
+	storeHeight := s.Height()
+	if height > storeHeight {
+		return fmt.Errorf("cannot rollback to height %d, store height is %d", height, storeHeight)
+	}
+	top := storeHeight
+	for {
+		_, err := s.loadHashFromIndex(ctx, top+1)
+		if errors.Is(err, ds.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		top++
+	}
+	// blocks are removed from the top, so the remaining ones are always contiguous
+	for h := top; h > height; h-- {
+		if err := s.DeleteBlockData(ctx, h); err != nil {
+			return fmt.Errorf("failed to delete block at height %d: %w", h, err)
+		}
+		if h <= storeHeight {
+			s.height.Store(h - 1)
+		}
+	}
+	return nil
+}
+
 // SaveBlockResponses saves block responses (events, tx responses, validator set updates, etc) in Store.
 func (s *DefaultStore) SaveBlockResponses(ctx context.Context, height uint64, responses *abci.ResponseFinalizeBlock) error {
 	data, err := responses.Marshal()
@@ -262,6 +330,25 @@ func (s *DefaultStore) GetMetadata(ctx context.Context, key string) ([]byte, err
 	return data, nil
 }
 
+// MetadataKeys returns keys of all values stored with SetMetadata, in lexicographical order.
+func (s *DefaultStore) MetadataKeys(ctx context.Context) ([]string, error) {
+	prefix := getMetaKey("")
+	results, err := s.db.Query(ctx, dsq.Query{Prefix: prefix, KeysOnly: true, Orders: []dsq.Order{dsq.OrderByKey{}}})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close() //nolint:errcheck
+
+	var keys []string
+	for entry := range results.Next() {
+		if entry.Error != nil {
+			return nil, entry.Error
+		}
+		keys = append(keys, strings.TrimPrefix(entry.Key, prefix+"/"))
+	}
+	return keys, nil
+}
+
 // loadHashFromIndex returns the hash of a block given its height
 func (s *DefaultStore) loadHashFromIndex(ctx context.Context, height uint64) (header.Hash, error) {
 	blob, err := s.db.Get(ctx, ds.NewKey(getIndexKey(height)))
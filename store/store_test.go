@@ -174,6 +174,29 @@ func TestRestart(t *testing.T) {
 	assert.Equal(expectedHeight, state2.LastBlockHeight)
 }
 
+func TestReadOnlyKVStore(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	kv, err := NewDefaultKVStore(tmpDir, "test", "test")
+	require.NoError(err)
+	require.NoError(New(kv).SetMetadata(ctx, "key", []byte("value")))
+	require.NoError(kv.Close())
+
+	kv, err = NewDefaultReadOnlyKVStore(tmpDir, "test", "test")
+	require.NoError(err)
+	s := New(kv)
+	defer func() {
+		require.NoError(s.Close())
+	}()
+	value, err := s.GetMetadata(ctx, "key")
+	require.NoError(err)
+	require.Equal([]byte("value"), value)
+	require.Error(s.SetMetadata(ctx, "key", []byte("other value")))
+}
+
 func TestBlockResponses(t *testing.T) {
 	t.Parallel()
 	assert := assert.New(t)
@@ -247,6 +270,10 @@ func TestMetadata(t *testing.T) {
 	v, err := s.GetMetadata(ctx, "unused key")
 	require.Error(err)
 	require.Nil(v)
+
+	keys, err := s.(*DefaultStore).MetadataKeys(ctx)
+	require.NoError(err)
+	require.Equal([]string{getKey(0), getKey(1), getKey(2), getKey(3), getKey(4)}, keys)
 }
 
 func TestExtendedCommits(t *testing.T) {
@@ -286,3 +313,59 @@ func TestExtendedCommits(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, commit)
 }
+
+func TestRollback(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kv, err := NewDefaultInMemoryKVStore()
+	require.NoError(err)
+	s := New(kv)
+
+	const n = 5
+	headers := make([]*types.SignedHeader, 0, n)
+	for i := uint64(1); i <= n; i++ {
+		header, data := types.GetRandomBlock(i, 1)
+		require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+		require.NoError(s.SaveBlockResponses(ctx, i, &abcitypes.ResponseFinalizeBlock{}))
+		s.SetHeight(ctx, i)
+		headers = append(headers, header)
+	}
+
+	require.Error(s.Rollback(ctx, n+1))
+	require.NoError(s.Rollback(ctx, 2))
+	require.Equal(uint64(2), s.Height())
+
+	for i, header := range headers {
+		height := uint64(i + 1) //nolint:gosec
+		_, _, err := s.GetBlockData(ctx, height)
+		_, _, hashErr := s.GetBlockByHash(ctx, header.Hash())
+		_, respErr := s.GetBlockResponses(ctx, height)
+		if height <= 2 {
+			require.NoError(err)
+			require.NoError(hashErr)
+			require.NoError(respErr)
+		} else {
+			require.ErrorIs(err, ds.ErrNotFound)
+			require.ErrorIs(hashErr, ds.ErrNotFound)
+			require.ErrorIs(respErr, ds.ErrNotFound)
+		}
+	}
+
+	// blocks can be saved again after rollback
+	header, data := types.GetRandomBlock(3, 1)
+	require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+	s.SetHeight(ctx, 3)
+	require.Equal(uint64(3), s.Height())
+
+	// blocks above the height of the store are removed too
+	header, data = types.GetRandomBlock(4, 1)
+	require.NoError(s.SaveBlockData(ctx, header, data, &header.Signature))
+	require.NoError(s.Rollback(ctx, 2))
+	require.Equal(uint64(2), s.Height())
+	_, _, err = s.GetBlockData(ctx, 4)
+	require.ErrorIs(err, ds.ErrNotFound)
+}
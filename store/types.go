@@ -24,6 +24,14 @@ type Store interface {
 	// GetBlockByHash returns block with given block header hash, or error if it's not found in Store.
 	GetBlockByHash(ctx context.Context, hash types.Hash) (*types.SignedHeader, *types.Data, error)
 
+	// DeleteBlockData removes block (header, data and signature) at given height from Store,
+	// along with block responses and extended commit saved for this height.
+	DeleteBlockData(ctx context.Context, height uint64) error
+
+	// Rollback removes all blocks above given height and sets height of the Store to given height.
+	// Blocks saved above the height of the Store are removed too. State is not modified by Rollback.
+	Rollback(ctx context.Context, height uint64) error
+
 	// SaveBlockResponses saves block responses (events, tx responses, validator set updates, etc) in Store.
 	SaveBlockResponses(ctx context.Context, height uint64, responses *abci.ResponseFinalizeBlock) error
 
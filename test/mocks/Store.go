@@ -37,6 +37,24 @@ func (_m *Store) Close() error {
 	return r0
 }
 
+// DeleteBlockData provides a mock function with given fields: ctx, height
+func (_m *Store) DeleteBlockData(ctx context.Context, height uint64) error {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlockData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBlockByHash provides a mock function with given fields: ctx, hash
 func (_m *Store) GetBlockByHash(ctx context.Context, hash header.Hash) (*types.SignedHeader, *types.Data, error) {
 	ret := _m.Called(ctx, hash)
@@ -311,6 +329,24 @@ func (_m *Store) Height() uint64 {
 	return r0
 }
 
+// Rollback provides a mock function with given fields: ctx, height
+func (_m *Store) Rollback(ctx context.Context, height uint64) error {
+	ret := _m.Called(ctx, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveBlockData provides a mock function with given fields: ctx, _a1, data, signature
 func (_m *Store) SaveBlockData(ctx context.Context, _a1 *types.SignedHeader, data *types.Data, signature *types.Signature) error {
 	ret := _m.Called(ctx, _a1, data, signature)
@@ -32,8 +32,8 @@ type Metadata struct {
 // Data defines Rollkit block data.
 type Data struct {
 	*Metadata
-	Txs Txs
-	// IntermediateStateRoots IntermediateStateRoots
+	Txs                    Txs
+	IntermediateStateRoots IntermediateStateRoots
 	// Note: Temporarily remove Evidence #896
 	// Evidence               EvidenceData
 }
@@ -85,7 +85,7 @@ func Validate(header *SignedHeader, data *Data) error {
 		}
 	}
 	// exclude Metadata while computing the data hash for comparison
-	d := Data{Txs: data.Txs}
+	d := Data{Txs: data.Txs, IntermediateStateRoots: data.IntermediateStateRoots}
 	dataHash := d.Hash()
 	if !bytes.Equal(dataHash[:], header.DataHash[:]) {
 		return errors.New("dataHash from the header does not match with hash of the block's data")
@@ -0,0 +1,62 @@
+package types
+
+import (
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtypes "github.com/cometbft/cometbft/types"
+)
+
+// Attestation is a signature of a single validator over a header.
+type Attestation struct {
+	ValidatorAddress cmtypes.Address
+	Signature        []byte
+}
+
+// BlockID returns cometBFT block ID of the header.
+func (h *Header) BlockID() cmtypes.BlockID {
+	return cmtypes.BlockID{
+		Hash: cmbytes.HexBytes(h.Hash()),
+		// for now, we don't care about part set headers
+	}
+}
+
+// Attest signs the header on behalf of the validator at the given index of the
+// validator set, using the provided signing function.
+func (h *Header) Attest(validatorAddress cmtypes.Address, validatorIndex int32, sign func([]byte) ([]byte, error)) (Attestation, error) {
+	signature, err := sign(h.AttestationSignBytes(validatorAddress, validatorIndex))
+	if err != nil {
+		return Attestation{}, err
+	}
+	return Attestation{ValidatorAddress: validatorAddress, Signature: signature}, nil
+}
+
+// NewCommit creates a cometBFT commit for the header from the given attestations.
+//
+// Signatures are ordered like validators in the validator set. Validators without
+// attestation are marked as absent.
+func NewCommit(h *Header, validators *cmtypes.ValidatorSet, attestations []Attestation) *cmtypes.Commit {
+	signatures := make(map[string][]byte, len(attestations))
+	for _, a := range attestations {
+		signatures[string(a.ValidatorAddress)] = a.Signature
+	}
+
+	commit := &cmtypes.Commit{
+		Height:     int64(h.Height()), //nolint:gosec
+		Round:      0,
+		BlockID:    h.BlockID(),
+		Signatures: make([]cmtypes.CommitSig, len(validators.Validators)),
+	}
+	for i, val := range validators.Validators {
+		signature, ok := signatures[string(val.Address)]
+		if !ok {
+			commit.Signatures[i] = cmtypes.NewCommitSigAbsent()
+			continue
+		}
+		commit.Signatures[i] = cmtypes.CommitSig{
+			BlockIDFlag:      cmtypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        h.Time(),
+			Signature:        signature,
+		}
+	}
+	return commit
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	cmtypes "github.com/cometbft/cometbft/types"
+
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+// ErrNotEquivocation is returned when headers of equivocation evidence do not conflict.
+var ErrNotEquivocation = errors.New("headers are not conflicting")
+
+func init() {
+	cmjson.RegisterType(&EquivocationEvidence{}, "rollkit/EquivocationEvidence")
+}
+
+var _ cmtypes.Evidence = &EquivocationEvidence{}
+
+// EquivocationEvidence proves that the sequencer signed two different headers at the same height.
+//
+// Headers are ordered by hash, so the same pair of headers always yields the same evidence.
+type EquivocationEvidence struct {
+	HeaderA *SignedHeader `json:"header_a"`
+	HeaderB *SignedHeader `json:"header_b"`
+}
+
+// ResultEquivocationEvidence is the result of the equivocation_evidence RPC method.
+type ResultEquivocationEvidence struct {
+	Evidence []*EquivocationEvidence `json:"evidence"`
+}
+
+// NewEquivocationEvidence creates evidence of equivocation from two conflicting headers.
+func NewEquivocationEvidence(a, b *SignedHeader) (*EquivocationEvidence, error) {
+	if bytes.Compare(a.Hash(), b.Hash()) > 0 {
+		a, b = b, a
+	}
+	ev := &EquivocationEvidence{HeaderA: a, HeaderB: b}
+	if err := ev.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// ValidateBasic checks that both headers are validly signed by the same proposer,
+// at the same height of the same chain, and that they are different.
+func (ev *EquivocationEvidence) ValidateBasic() error {
+	if ev.HeaderA == nil || ev.HeaderB == nil {
+		return errors.New("equivocation evidence must contain two headers")
+	}
+	if err := ev.HeaderA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid header A: %w", err)
+	}
+	if err := ev.HeaderB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid header B: %w", err)
+	}
+	if ev.HeaderA.ChainID() != ev.HeaderB.ChainID() {
+		return fmt.Errorf("%w: chain ID mismatch", ErrNotEquivocation)
+	}
+	if ev.HeaderA.Height() != ev.HeaderB.Height() {
+		return fmt.Errorf("%w: height mismatch", ErrNotEquivocation)
+	}
+	if !bytes.Equal(ev.HeaderA.ProposerAddress, ev.HeaderB.ProposerAddress) {
+		return fmt.Errorf("%w: proposer mismatch", ErrNotEquivocation)
+	}
+	if bytes.Equal(ev.HeaderA.Hash(), ev.HeaderB.Hash()) {
+		return fmt.Errorf("%w: headers are identical", ErrNotEquivocation)
+	}
+	return nil
+}
+
+// ABCI returns the application relevant representation of the evidence.
+func (ev *EquivocationEvidence) ABCI() []abci.Misbehavior {
+	var power int64
+	if _, val := ev.HeaderA.Validators.GetByAddress(ev.HeaderA.ProposerAddress); val != nil {
+		power = val.VotingPower
+	}
+	return []abci.Misbehavior{{
+		Type: abci.MisbehaviorType_DUPLICATE_VOTE,
+		Validator: abci.Validator{
+			Address: ev.HeaderA.ProposerAddress,
+			Power:   power,
+		},
+		Height:           ev.Height(),
+		Time:             ev.Time(),
+		TotalVotingPower: ev.HeaderA.Validators.TotalVotingPower(),
+	}}
+}
+
+// Bytes returns the proto-encoded evidence.
+func (ev *EquivocationEvidence) Bytes() []byte {
+	bz, err := ev.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (ev *EquivocationEvidence) Hash() []byte {
+	return tmhash.Sum(ev.Bytes())
+}
+
+// Height returns the height of conflicting headers.
+func (ev *EquivocationEvidence) Height() int64 {
+	return int64(ev.HeaderA.Height()) //nolint:gosec
+}
+
+// Time returns the time of the first header.
+func (ev *EquivocationEvidence) Time() time.Time {
+	return ev.HeaderA.Time()
+}
+
+// String returns a string representation of the evidence.
+func (ev *EquivocationEvidence) String() string {
+	return fmt.Sprintf("EquivocationEvidence{Height: %d, Proposer: %X, HashA: %s, HashB: %s}",
+		ev.HeaderA.Height(), ev.HeaderA.ProposerAddress, ev.HeaderA.Hash(), ev.HeaderB.Hash())
+}
+
+// ToProto converts EquivocationEvidence into protobuf representation and returns it.
+func (ev *EquivocationEvidence) ToProto() (*pb.EquivocationEvidence, error) {
+	a, err := ev.HeaderA.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	b, err := ev.HeaderB.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EquivocationEvidence{HeaderA: a, HeaderB: b}, nil
+}
+
+// FromProto fills EquivocationEvidence with data from its protobuf representation.
+func (ev *EquivocationEvidence) FromProto(other *pb.EquivocationEvidence) error {
+	if other.HeaderA == nil || other.HeaderB == nil {
+		return errors.New("equivocation evidence must contain two headers")
+	}
+	ev.HeaderA = new(SignedHeader)
+	if err := ev.HeaderA.FromProto(other.HeaderA); err != nil {
+		return err
+	}
+	ev.HeaderB = new(SignedHeader)
+	return ev.HeaderB.FromProto(other.HeaderB)
+}
+
+// MarshalBinary encodes EquivocationEvidence into binary form and returns it.
+func (ev *EquivocationEvidence) MarshalBinary() ([]byte, error) {
+	pev, err := ev.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pev.Marshal()
+}
+
+// UnmarshalBinary decodes binary form of EquivocationEvidence into object.
+func (ev *EquivocationEvidence) UnmarshalBinary(data []byte) error {
+	var pev pb.EquivocationEvidence
+	if err := pev.Unmarshal(data); err != nil {
+		return err
+	}
+	return ev.FromProto(&pev)
+}
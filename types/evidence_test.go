@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	cmcrypto "github.com/cometbft/cometbft/crypto"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	cmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getConflictingHeader(t *testing.T, sh *SignedHeader, privKey cmcrypto.PrivKey) *SignedHeader {
+	t.Helper()
+	conflicting := *sh
+	conflicting.AppHash = GetRandomBytes(32)
+	signature, err := GetSignature(conflicting.Header, privKey)
+	require.NoError(t, err)
+	conflicting.Signature = *signature
+	return &conflicting
+}
+
+func TestEquivocationEvidence(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	sh, privKey, err := GetRandomSignedHeader()
+	require.NoError(err)
+	conflicting := getConflictingHeader(t, sh, privKey)
+
+	ev, err := NewEquivocationEvidence(sh, conflicting)
+	require.NoError(err)
+	assert.Equal(int64(sh.Height()), ev.Height())
+	require.Len(ev.ABCI(), 1)
+	assert.Equal(sh.ProposerAddress, ev.ABCI()[0].Validator.Address)
+
+	// evidence doesn't depend on order of headers
+	reversed, err := NewEquivocationEvidence(conflicting, sh)
+	require.NoError(err)
+	assert.Equal(ev.Hash(), reversed.Hash())
+
+	t.Run("binary round trip", func(t *testing.T) {
+		decoded := new(EquivocationEvidence)
+		require.NoError(decoded.UnmarshalBinary(ev.Bytes()))
+		assert.NoError(decoded.ValidateBasic())
+		assert.Equal(ev.Hash(), decoded.Hash())
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		bz, err := cmjson.Marshal(cmtypes.Evidence(ev))
+		require.NoError(err)
+		var decoded cmtypes.Evidence
+		require.NoError(cmjson.Unmarshal(bz, &decoded))
+		require.IsType(&EquivocationEvidence{}, decoded)
+		assert.NoError(decoded.ValidateBasic())
+		assert.Equal(ev.Hash(), decoded.Hash())
+	})
+
+	t.Run("identical headers", func(t *testing.T) {
+		_, err := NewEquivocationEvidence(sh, sh)
+		assert.ErrorIs(err, ErrNotEquivocation)
+	})
+
+	t.Run("different heights", func(t *testing.T) {
+		next, err := GetRandomNextSignedHeader(sh, privKey)
+		require.NoError(err)
+		_, err = NewEquivocationEvidence(sh, next)
+		assert.ErrorIs(err, ErrNotEquivocation)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		forged := *conflicting
+		forged.Signature = GetRandomBytes(64)
+		_, err := NewEquivocationEvidence(sh, &forged)
+		assert.ErrorIs(err, ErrSignatureVerificationFailed)
+	})
+}
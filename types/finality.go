@@ -0,0 +1,48 @@
+package types
+
+import (
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmjson "github.com/cometbft/cometbft/libs/json"
+	cmtypes "github.com/cometbft/cometbft/types"
+)
+
+// EventNewDAInclusion is published on the event bus when a block is included in the DA layer.
+const EventNewDAInclusion = "NewDAInclusion"
+
+// EventQueryNewDAInclusion is the query matching EventNewDAInclusion events.
+var EventQueryNewDAInclusion = cmtypes.QueryForEvent(EventNewDAInclusion)
+
+func init() {
+	cmjson.RegisterType(EventDataNewDAInclusion{}, "rollkit/event/NewDAInclusion")
+}
+
+// EventDataNewDAInclusion is the payload of EventNewDAInclusion events.
+type EventDataNewDAInclusion struct {
+	Height   uint64           `json:"height"`
+	Hash     cmbytes.HexBytes `json:"hash"`
+	DAHeight uint64           `json:"da_height"`
+}
+
+// FinalityStatus describes how final a block is.
+type FinalityStatus string
+
+const (
+	// FinalityStatusSoftConfirmed is the status of blocks produced by the sequencer,
+	// but not yet included in the DA layer.
+	FinalityStatusSoftConfirmed FinalityStatus = "soft_confirmed"
+	// FinalityStatusDAIncluded is the status of blocks included in the DA layer.
+	FinalityStatusDAIncluded FinalityStatus = "da_included"
+)
+
+// ResultDAIncludedHeight is the result of the da_included_height RPC method.
+type ResultDAIncludedHeight struct {
+	// Height is the rollup height up to which all blocks are included in the DA layer.
+	Height uint64 `json:"height"`
+}
+
+// ResultBlockFinality is the result of the block_finality RPC method.
+type ResultBlockFinality struct {
+	Height uint64           `json:"height"`
+	Hash   cmbytes.HexBytes `json:"hash"`
+	Status FinalityStatus   `json:"status"`
+}
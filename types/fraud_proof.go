@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/rollkit/rollkit/types/pb/rollkit"
+)
+
+var (
+	// ErrMissingIntermediateStateRoots is returned when block data does not carry intermediate state roots.
+	ErrMissingIntermediateStateRoots = errors.New("block data has no intermediate state roots")
+
+	// ErrInvalidTxIndex is returned when a fraud proof refers to a transaction outside of the block.
+	ErrInvalidTxIndex = errors.New("transaction index out of range")
+)
+
+// FraudProof is a portable proof that the sequencer committed to an invalid
+// intermediate state root for a single transaction.
+//
+// The proof carries the signed header and the block data, so that any node can
+// check that the intermediate state roots were committed to by the sequencer and
+// then re-execute the transaction at TxIndex on top of its pre-state root.
+type FraudProof struct {
+	SignedHeader *SignedHeader `json:"signed_header"`
+	Data         *Data         `json:"data"`
+	TxIndex      uint64        `json:"tx_index"`
+}
+
+// ResultFraudProofs is the result of the fraud_proofs RPC method.
+type ResultFraudProofs struct {
+	FraudProofs []*FraudProof `json:"fraud_proofs"`
+}
+
+// Tx returns the disputed transaction.
+func (fp *FraudProof) Tx() Tx {
+	return fp.Data.Txs[fp.TxIndex]
+}
+
+// PreStateRoot returns the intermediate state root before execution of the disputed transaction.
+func (fp *FraudProof) PreStateRoot() []byte {
+	return fp.Data.IntermediateStateRoots.RawRootsList[fp.TxIndex]
+}
+
+// PostStateRoot returns the intermediate state root claimed by the sequencer
+// after execution of the disputed transaction.
+func (fp *FraudProof) PostStateRoot() []byte {
+	return fp.Data.IntermediateStateRoots.RawRootsList[fp.TxIndex+1]
+}
+
+// ValidateBasic checks that the proof is well formed and that the disputed
+// intermediate state roots are committed to by the signed header.
+func (fp *FraudProof) ValidateBasic() error {
+	if fp.SignedHeader == nil || fp.Data == nil {
+		return errors.New("fraud proof must contain signed header and data")
+	}
+	if err := fp.SignedHeader.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid signed header: %w", err)
+	}
+	if err := Validate(fp.SignedHeader, fp.Data); err != nil {
+		return err
+	}
+	isrs := fp.Data.IntermediateStateRoots.RawRootsList
+	if len(isrs) == 0 {
+		return ErrMissingIntermediateStateRoots
+	}
+	if len(isrs) != len(fp.Data.Txs)+1 {
+		return fmt.Errorf("invalid length of ISR list: %d, expected length: %d", len(isrs), len(fp.Data.Txs)+1)
+	}
+	if fp.TxIndex >= uint64(len(fp.Data.Txs)) {
+		return ErrInvalidTxIndex
+	}
+	return nil
+}
+
+// ToProto converts FraudProof into protobuf representation and returns it.
+func (fp *FraudProof) ToProto() (*pb.FraudProof, error) {
+	sh, err := fp.SignedHeader.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FraudProof{
+		SignedHeader: sh,
+		Data:         fp.Data.ToProto(),
+		TxIndex:      fp.TxIndex,
+	}, nil
+}
+
+// FromProto fills FraudProof with data from its protobuf representation.
+func (fp *FraudProof) FromProto(other *pb.FraudProof) error {
+	if other.SignedHeader == nil || other.Data == nil {
+		return errors.New("fraud proof must contain signed header and data")
+	}
+	fp.SignedHeader = new(SignedHeader)
+	if err := fp.SignedHeader.FromProto(other.SignedHeader); err != nil {
+		return err
+	}
+	fp.Data = new(Data)
+	if err := fp.Data.FromProto(other.Data); err != nil {
+		return err
+	}
+	fp.TxIndex = other.TxIndex
+	return nil
+}
+
+// MarshalBinary encodes FraudProof into binary form and returns it.
+func (fp *FraudProof) MarshalBinary() ([]byte, error) {
+	pfp, err := fp.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pfp.Marshal()
+}
+
+// UnmarshalBinary decodes binary form of FraudProof into object.
+func (fp *FraudProof) UnmarshalBinary(data []byte) error {
+	var pfp pb.FraudProof
+	if err := pfp.Unmarshal(data); err != nil {
+		return err
+	}
+	return fp.FromProto(&pfp)
+}
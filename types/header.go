@@ -126,7 +126,23 @@ func (h *Header) ValidateBasic() error {
 // MakeCometBFTVote make a cometBFT consensus vote for the sequencer to commit
 // we have the sequencer signs cometBFT consensus vote for compatibility with cometBFT client
 func (h *Header) MakeCometBFTVote() []byte {
-	vote := cmtproto.Vote{
+	// proposerAddress = sequencer = validator
+	return h.AttestationSignBytes(h.ProposerAddress, 0)
+}
+
+// AttestationSignBytes returns the bytes signed by the validator at the given index
+// of the validator set to attest the header.
+//
+// Those are sign bytes of a cometBFT precommit for the header, so attestations
+// can be verified with cmtypes.ValidatorSet.VerifyCommitLight.
+func (h *Header) AttestationSignBytes(validatorAddress []byte, validatorIndex int32) []byte {
+	return cmtypes.VoteSignBytes(h.ChainID(), h.AttestationVote(validatorAddress, validatorIndex))
+}
+
+// AttestationVote returns the cometBFT precommit for the header signed by the validator at the
+// given index of the validator set.
+func (h *Header) AttestationVote(validatorAddress []byte, validatorIndex int32) *cmtproto.Vote {
+	return &cmtproto.Vote{
 		Type:   cmtproto.PrecommitType,
 		Height: int64(h.Height()), //nolint:gosec
 		Round:  0,
@@ -135,15 +151,10 @@ func (h *Header) MakeCometBFTVote() []byte {
 			Hash:          cmbytes.HexBytes(h.Hash()),
 			PartSetHeader: cmtproto.PartSetHeader{},
 		},
-		Timestamp: h.Time(),
-		// proposerAddress = sequencer = validator
-		ValidatorAddress: h.ProposerAddress,
-		ValidatorIndex:   0,
+		Timestamp:        h.Time(),
+		ValidatorAddress: validatorAddress,
+		ValidatorIndex:   validatorIndex,
 	}
-	chainID := h.ChainID()
-	consensusVoteBytes := cmtypes.VoteSignBytes(chainID, &vote)
-
-	return consensusVoteBytes
 }
 
 var _ header.Header[*Header] = &Header{}
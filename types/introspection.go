@@ -0,0 +1,79 @@
+package types
+
+import "time"
+
+// ResultPendingHeaders is the result of the rollkit_pending_headers RPC method.
+type ResultPendingHeaders struct {
+	// Count is the number of headers not yet submitted to the DA layer.
+	Count uint64 `json:"count"`
+	// FromHeight and ToHeight are the heights of the first and the last pending header.
+	// Both are 0 if there are no pending headers.
+	FromHeight uint64 `json:"from_height"`
+	ToHeight   uint64 `json:"to_height"`
+}
+
+// DASubmission describes the result of the latest attempt to submit headers to the DA layer.
+type DASubmission struct {
+	Time     time.Time `json:"time"`
+	Code     string    `json:"code"`
+	Message  string    `json:"message,omitempty"`
+	DAHeight uint64    `json:"da_height"`
+	// SubmittedCount is the number of headers submitted in this attempt.
+	SubmittedCount uint64  `json:"submitted_count"`
+	GasPrice       float64 `json:"gas_price"`
+}
+
+// ResultDAStatus is the result of the rollkit_da_status RPC method.
+type ResultDAStatus struct {
+	// DAHeight is the height of the latest DA block processed by the node.
+	DAHeight uint64 `json:"da_height"`
+	// DAIncludedHeight is the rollup height up to which all blocks are included in the DA layer.
+	DAIncludedHeight uint64 `json:"da_included_height"`
+	// GasPrice is the gas price used by the latest submission to the DA layer
+	// (or configured gas price if nothing was submitted yet).
+	GasPrice      float64 `json:"gas_price"`
+	GasMultiplier float64 `json:"gas_multiplier"`
+	// LastSubmission is nil if the node didn't submit anything to the DA layer yet.
+	LastSubmission *DASubmission `json:"last_submission"`
+}
+
+// ResultBatchQueue is the result of the rollkit_batch_queue RPC method.
+type ResultBatchQueue struct {
+	// Depth is the number of batches received from the sequencer, waiting for block production.
+	Depth int `json:"depth"`
+}
+
+// ResultSyncStatus is the result of the rollkit_sync_status RPC method.
+type ResultSyncStatus struct {
+	// Height is the height of the latest block in the store.
+	Height uint64 `json:"height"`
+	// HeaderStoreHeight and DataStoreHeight are heights of P2P sync stores.
+	HeaderStoreHeight uint64 `json:"header_store_height"`
+	DataStoreHeight   uint64 `json:"data_store_height"`
+	// DAHeight is the height of the latest DA block processed by the node.
+	DAHeight uint64 `json:"da_height"`
+	// DAIncludedHeight is the rollup height up to which all blocks are included in the DA layer.
+	DAIncludedHeight uint64 `json:"da_included_height"`
+	// DABlockHeight is the height of the highest rollup block found in the DA layer.
+	DABlockHeight uint64 `json:"da_block_height"`
+	// TargetHeight is the highest known height (from P2P sync stores or DA).
+	TargetHeight uint64 `json:"target_height"`
+	// BlocksBehind is the number of blocks between Height and TargetHeight.
+	BlocksBehind uint64 `json:"blocks_behind"`
+	// CatchingUp is true if the node is more than the configured threshold of blocks behind TargetHeight.
+	CatchingUp bool `json:"catching_up"`
+}
+
+// ComponentHealth is the result of a health check of a single node component.
+type ComponentHealth struct {
+	Healthy bool `json:"healthy"`
+	// Message describes the state of the component or the reason of the failure.
+	Message string `json:"message,omitempty"`
+}
+
+// ResultHealth is the result of /healthz and /readyz endpoints.
+type ResultHealth struct {
+	// Healthy is true if all the components are healthy.
+	Healthy    bool                       `json:"healthy"`
+	Components map[string]ComponentHealth `json:"components"`
+}
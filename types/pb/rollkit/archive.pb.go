@@ -0,0 +1,999 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollkit/archive.proto
+
+package rollkit
+
+import (
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchiveHeader is the first record of a chain archive. It's followed by
+// to_height - from_height + 1 ArchiveBlock records and an ArchiveTrailer.
+type ArchiveHeader struct {
+	Version    uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *ArchiveHeader) Reset()         { *m = ArchiveHeader{} }
+func (m *ArchiveHeader) String() string { return proto.CompactTextString(m) }
+func (*ArchiveHeader) ProtoMessage()    {}
+func (*ArchiveHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_317fd8936a654aaa, []int{0}
+}
+func (m *ArchiveHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveHeader.Merge(m, src)
+}
+func (m *ArchiveHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveHeader proto.InternalMessageInfo
+
+func (m *ArchiveHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ArchiveHeader) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ArchiveHeader) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// ArchiveBlock is a block of a chain archive, along with its signature and
+// the responses of executing it.
+type ArchiveBlock struct {
+	Header    *SignedHeader                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data      *Data                        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte                       `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Responses *types.ResponseFinalizeBlock `protobuf:"bytes,4,opt,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *ArchiveBlock) Reset()         { *m = ArchiveBlock{} }
+func (m *ArchiveBlock) String() string { return proto.CompactTextString(m) }
+func (*ArchiveBlock) ProtoMessage()    {}
+func (*ArchiveBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_317fd8936a654aaa, []int{1}
+}
+func (m *ArchiveBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveBlock.Merge(m, src)
+}
+func (m *ArchiveBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveBlock proto.InternalMessageInfo
+
+func (m *ArchiveBlock) GetHeader() *SignedHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetData() *Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ArchiveBlock) GetResponses() *types.ResponseFinalizeBlock {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// ArchiveTrailer is the last record of a chain archive.
+type ArchiveTrailer struct {
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// SHA-256 checksum of all bytes of the archive preceding the trailer.
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *ArchiveTrailer) Reset()         { *m = ArchiveTrailer{} }
+func (m *ArchiveTrailer) String() string { return proto.CompactTextString(m) }
+func (*ArchiveTrailer) ProtoMessage()    {}
+func (*ArchiveTrailer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_317fd8936a654aaa, []int{2}
+}
+func (m *ArchiveTrailer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchiveTrailer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchiveTrailer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchiveTrailer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveTrailer.Merge(m, src)
+}
+func (m *ArchiveTrailer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchiveTrailer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveTrailer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveTrailer proto.InternalMessageInfo
+
+func (m *ArchiveTrailer) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ArchiveTrailer) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ArchiveHeader)(nil), "rollkit.ArchiveHeader")
+	proto.RegisterType((*ArchiveBlock)(nil), "rollkit.ArchiveBlock")
+	proto.RegisterType((*ArchiveTrailer)(nil), "rollkit.ArchiveTrailer")
+}
+
+func init() { proto.RegisterFile("rollkit/archive.proto", fileDescriptor_317fd8936a654aaa) }
+
+var fileDescriptor_317fd8936a654aaa = []byte{
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbf, 0x6e, 0xe2, 0x30,
+	0x1c, 0xc7, 0xf1, 0x5d, 0x04, 0xc4, 0x81, 0x1b, 0x2c, 0x71, 0xca, 0xc1, 0x29, 0xc7, 0x31, 0x54,
+	0x48, 0x55, 0x13, 0x89, 0x3e, 0x41, 0x11, 0xad, 0xe8, 0xea, 0x76, 0xea, 0x82, 0x9c, 0xc4, 0x4d,
+	0x2c, 0x12, 0x3b, 0xb2, 0x0d, 0x52, 0x3b, 0xf6, 0x09, 0xfa, 0x50, 0x1d, 0x3a, 0x32, 0x76, 0xac,
+	0xe0, 0x45, 0xaa, 0x38, 0x09, 0x48, 0x9d, 0xa2, 0xef, 0x9f, 0x58, 0x9f, 0xdf, 0xef, 0x07, 0x07,
+	0x52, 0x64, 0xd9, 0x9a, 0xe9, 0x80, 0xc8, 0x28, 0x65, 0x5b, 0xea, 0x17, 0x52, 0x68, 0x81, 0x3a,
+	0xb5, 0x3d, 0x3c, 0xe6, 0xf5, 0xb7, 0xca, 0x87, 0x23, 0x4d, 0x79, 0x4c, 0x65, 0xce, 0xb8, 0x0e,
+	0x48, 0x18, 0xb1, 0x40, 0x3f, 0x15, 0x54, 0x55, 0xe1, 0xe4, 0x05, 0xc0, 0xfe, 0x55, 0xf5, 0xdc,
+	0x92, 0x92, 0x98, 0x4a, 0xe4, 0xc2, 0xce, 0x96, 0x4a, 0xc5, 0x04, 0x77, 0xc1, 0x18, 0x4c, 0xfb,
+	0xb8, 0x91, 0xe8, 0x0f, 0xec, 0x46, 0x29, 0x61, 0x7c, 0xc5, 0x62, 0xf7, 0xc7, 0x18, 0x4c, 0x6d,
+	0xdc, 0x31, 0xfa, 0x36, 0x46, 0xff, 0xa0, 0xf3, 0x28, 0x45, 0xbe, 0x4a, 0x29, 0x4b, 0x52, 0xed,
+	0xfe, 0x1c, 0x83, 0xa9, 0x85, 0x61, 0x69, 0x2d, 0x8d, 0x83, 0x46, 0xd0, 0xd6, 0xa2, 0x89, 0x2d,
+	0x13, 0x77, 0xb5, 0xa8, 0xc2, 0xc9, 0x1b, 0x80, 0xbd, 0x1a, 0x62, 0x9e, 0x89, 0x68, 0x8d, 0x2e,
+	0x60, 0x3b, 0x35, 0x34, 0x06, 0xc1, 0x99, 0x0d, 0xfc, 0x66, 0xa4, 0x3b, 0x96, 0x70, 0x1a, 0x57,
+	0xa8, 0xb8, 0x2e, 0xa1, 0xff, 0xd0, 0x8a, 0x89, 0x26, 0x06, 0xca, 0x99, 0xf5, 0x8f, 0xe5, 0x05,
+	0xd1, 0x04, 0x9b, 0x08, 0xfd, 0x85, 0xb6, 0x62, 0x09, 0x27, 0x7a, 0x23, 0xa9, 0xc1, 0xeb, 0xe1,
+	0x93, 0x81, 0x16, 0xd0, 0x96, 0x54, 0x15, 0x82, 0x2b, 0xaa, 0x0c, 0x9d, 0x33, 0x3b, 0xf3, 0x4f,
+	0x6b, 0xf3, 0xcb, 0xb5, 0xf9, 0xb8, 0x6e, 0xdc, 0x30, 0x4e, 0x32, 0xf6, 0x5c, 0xa1, 0xe2, 0xd3,
+	0x8f, 0x93, 0x05, 0xfc, 0x55, 0x4f, 0x71, 0x2f, 0x09, 0xcb, 0xa8, 0x44, 0xbf, 0x61, 0x3b, 0x2c,
+	0x5b, 0xca, 0xcc, 0x61, 0xe1, 0x5a, 0xa1, 0x61, 0xb9, 0x49, 0x1a, 0xad, 0xd5, 0x26, 0x37, 0xd0,
+	0x3d, 0x7c, 0xd4, 0xf3, 0xeb, 0xf7, 0xbd, 0x07, 0x76, 0x7b, 0x0f, 0x7c, 0xee, 0x3d, 0xf0, 0x7a,
+	0xf0, 0x5a, 0xbb, 0x83, 0xd7, 0xfa, 0x38, 0x78, 0xad, 0x87, 0xf3, 0x84, 0xe9, 0x74, 0x13, 0xfa,
+	0x91, 0xc8, 0x83, 0x6f, 0xa7, 0xae, 0x6e, 0x1a, 0x14, 0x61, 0x63, 0x84, 0x6d, 0x73, 0xdf, 0xcb,
+	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x44, 0x7c, 0x4a, 0x35, 0x02, 0x00, 0x00,
+}
+
+func (m *ArchiveHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Responses != nil {
+		{
+			size, err := m.Responses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchiveTrailer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchiveTrailer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchiveTrailer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchiveHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovArchive(uint64(m.Version))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovArchive(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovArchive(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *ArchiveBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Responses != nil {
+		l = m.Responses.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchiveTrailer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovArchive(uint64(m.Blocks))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	return n
+}
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchive(x uint64) (n int) {
+	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchiveHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &SignedHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Data{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Responses == nil {
+				m.Responses = &types.ResponseFinalizeBlock{}
+			}
+			if err := m.Responses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveTrailer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveTrailer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveTrailer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -233,6 +233,9 @@ type SignedHeader struct {
 	Header     *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Signature  []byte              `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	Validators *types.ValidatorSet `protobuf:"bytes,3,opt,name=validators,proto3" json:"validators,omitempty"`
+	// Optional commit of the attester committee. When set, it must carry
+	// signatures of more than 2/3 of the voting power of validators.
+	Commit *types.Commit `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
 }
 
 func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
@@ -289,6 +292,13 @@ func (m *SignedHeader) GetValidators() *types.ValidatorSet {
 	return nil
 }
 
+func (m *SignedHeader) GetCommit() *types.Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
 type Metadata struct {
 	// Rollup chain id
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -362,8 +372,9 @@ func (m *Metadata) GetLastDataHash() []byte {
 }
 
 type Data struct {
-	Metadata *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Txs      [][]byte  `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	Metadata               *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Txs                    [][]byte  `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	IntermediateStateRoots [][]byte  `protobuf:"bytes,3,rep,name=intermediate_state_roots,json=intermediateStateRoots,proto3" json:"intermediate_state_roots,omitempty"`
 }
 
 func (m *Data) Reset()         { *m = Data{} }
@@ -413,6 +424,13 @@ func (m *Data) GetTxs() [][]byte {
 	return nil
 }
 
+func (m *Data) GetIntermediateStateRoots() [][]byte {
+	if m != nil {
+		return m.IntermediateStateRoots
+	}
+	return nil
+}
+
 type TxWithISRs struct {
 	PreIsr  []byte `protobuf:"bytes,1,opt,name=pre_isr,json=preIsr,proto3" json:"pre_isr,omitempty"`
 	Tx      []byte `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
@@ -473,6 +491,122 @@ func (m *TxWithISRs) GetPostIsr() []byte {
 	return nil
 }
 
+// FraudProof is a portable proof that the intermediate state root committed
+// after the transaction at tx_index does not match re-execution.
+type FraudProof struct {
+	SignedHeader *SignedHeader `protobuf:"bytes,1,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
+	Data         *Data         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	TxIndex      uint64        `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *FraudProof) Reset()         { *m = FraudProof{} }
+func (m *FraudProof) String() string { return proto.CompactTextString(m) }
+func (*FraudProof) ProtoMessage()    {}
+func (*FraudProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed489fb7f4d78b3f, []int{6}
+}
+func (m *FraudProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FraudProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FraudProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FraudProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudProof.Merge(m, src)
+}
+func (m *FraudProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FraudProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FraudProof proto.InternalMessageInfo
+
+func (m *FraudProof) GetSignedHeader() *SignedHeader {
+	if m != nil {
+		return m.SignedHeader
+	}
+	return nil
+}
+
+func (m *FraudProof) GetData() *Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FraudProof) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+// EquivocationEvidence proves that the sequencer signed two different headers
+// at the same height.
+type EquivocationEvidence struct {
+	HeaderA *SignedHeader `protobuf:"bytes,1,opt,name=header_a,json=headerA,proto3" json:"header_a,omitempty"`
+	HeaderB *SignedHeader `protobuf:"bytes,2,opt,name=header_b,json=headerB,proto3" json:"header_b,omitempty"`
+}
+
+func (m *EquivocationEvidence) Reset()         { *m = EquivocationEvidence{} }
+func (m *EquivocationEvidence) String() string { return proto.CompactTextString(m) }
+func (*EquivocationEvidence) ProtoMessage()    {}
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed489fb7f4d78b3f, []int{7}
+}
+func (m *EquivocationEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EquivocationEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EquivocationEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationEvidence.Merge(m, src)
+}
+func (m *EquivocationEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationEvidence proto.InternalMessageInfo
+
+func (m *EquivocationEvidence) GetHeaderA() *SignedHeader {
+	if m != nil {
+		return m.HeaderA
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetHeaderB() *SignedHeader {
+	if m != nil {
+		return m.HeaderB
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Version)(nil), "rollkit.Version")
 	proto.RegisterType((*Header)(nil), "rollkit.Header")
@@ -480,49 +614,61 @@ func init() {
 	proto.RegisterType((*Metadata)(nil), "rollkit.Metadata")
 	proto.RegisterType((*Data)(nil), "rollkit.Data")
 	proto.RegisterType((*TxWithISRs)(nil), "rollkit.TxWithISRs")
+	proto.RegisterType((*FraudProof)(nil), "rollkit.FraudProof")
+	proto.RegisterType((*EquivocationEvidence)(nil), "rollkit.EquivocationEvidence")
 }
 
 func init() { proto.RegisterFile("rollkit/rollkit.proto", fileDescriptor_ed489fb7f4d78b3f) }
 
 var fileDescriptor_ed489fb7f4d78b3f = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcb, 0x6e, 0x13, 0x3f,
-	0x14, 0xc6, 0x3b, 0x49, 0x9a, 0x49, 0x4e, 0xe7, 0xdf, 0xa6, 0xd6, 0x1f, 0x18, 0x2e, 0x1a, 0x45,
-	0x11, 0x88, 0x50, 0x44, 0x22, 0xca, 0x1e, 0x89, 0x9b, 0x68, 0x16, 0x48, 0xc8, 0x45, 0x45, 0x62,
-	0x33, 0x72, 0x32, 0x56, 0xc6, 0xea, 0xcc, 0xd8, 0xb2, 0x9d, 0x12, 0xde, 0x82, 0x0d, 0x6f, 0xc1,
-	0x83, 0xb0, 0xec, 0x92, 0x25, 0x6a, 0x5e, 0x04, 0xf9, 0x32, 0x13, 0xca, 0x8e, 0x55, 0xce, 0xf9,
-	0xfc, 0xb3, 0xe7, 0x8b, 0xbf, 0x63, 0xb8, 0x21, 0x79, 0x51, 0x9c, 0x33, 0x3d, 0xf5, 0xbf, 0x13,
-	0x21, 0xb9, 0xe6, 0x28, 0xf4, 0xed, 0x9d, 0xa1, 0xa6, 0x55, 0x46, 0x65, 0xc9, 0x2a, 0x3d, 0xd5,
-	0x5f, 0x04, 0x55, 0xd3, 0x0b, 0x52, 0xb0, 0x8c, 0x68, 0x2e, 0x1d, 0x3a, 0x7a, 0x0a, 0xe1, 0x19,
-	0x95, 0x8a, 0xf1, 0x0a, 0xfd, 0x0f, 0xbb, 0xf3, 0x82, 0x2f, 0xce, 0xe3, 0x60, 0x18, 0x8c, 0x3b,
-	0xd8, 0x35, 0x68, 0x00, 0x6d, 0x22, 0x44, 0xdc, 0xb2, 0x9a, 0x29, 0x47, 0xdf, 0xdb, 0xd0, 0x3d,
-	0xa1, 0x24, 0xa3, 0x12, 0x1d, 0x41, 0x78, 0xe1, 0x76, 0xdb, 0x4d, 0x7b, 0xc7, 0x83, 0x49, 0xed,
-	0xc4, 0x9f, 0x8a, 0x6b, 0x00, 0xdd, 0x84, 0x6e, 0x4e, 0xd9, 0x32, 0xd7, 0xfe, 0x2c, 0xdf, 0x21,
-	0x04, 0x1d, 0xcd, 0x4a, 0x1a, 0xb7, 0xad, 0x6a, 0x6b, 0x34, 0x86, 0x41, 0x41, 0x94, 0x4e, 0x73,
-	0xfb, 0x99, 0x34, 0x27, 0x2a, 0x8f, 0x3b, 0xc3, 0x60, 0x1c, 0xe1, 0x7d, 0xa3, 0xbb, 0xaf, 0x9f,
-	0x10, 0x95, 0x37, 0xe4, 0x82, 0x97, 0x25, 0xd3, 0x8e, 0xdc, 0xdd, 0x92, 0xaf, 0xac, 0x6c, 0xc9,
-	0xbb, 0xd0, 0xcf, 0x88, 0x26, 0x0e, 0xe9, 0x5a, 0xa4, 0x67, 0x04, 0xbb, 0xf8, 0x00, 0xf6, 0x17,
-	0xbc, 0x52, 0xb4, 0x52, 0x2b, 0xe5, 0x88, 0xd0, 0x12, 0xff, 0x35, 0xaa, 0xc5, 0x6e, 0x43, 0x8f,
-	0x08, 0xe1, 0x80, 0x9e, 0x05, 0x42, 0x22, 0x84, 0x5d, 0x3a, 0x82, 0x43, 0x6b, 0x44, 0x52, 0xb5,
-	0x2a, 0xb4, 0x3f, 0xa4, 0x6f, 0x99, 0x03, 0xb3, 0x80, 0x9d, 0x6e, 0xd9, 0x47, 0x30, 0x10, 0x92,
-	0x0b, 0xae, 0xa8, 0x4c, 0x49, 0x96, 0x49, 0xaa, 0x54, 0x0c, 0x0e, 0xad, 0xf5, 0x17, 0x4e, 0x36,
-	0xc6, 0x9a, 0xc8, 0xdc, 0x99, 0x7b, 0xce, 0x58, 0xa3, 0xd6, 0xc6, 0x16, 0x39, 0x61, 0x55, 0xca,
-	0xb2, 0x38, 0x1a, 0x06, 0xe3, 0x3e, 0x0e, 0x6d, 0x3f, 0xcb, 0x46, 0xdf, 0x02, 0x88, 0x4e, 0xd9,
-	0xb2, 0xa2, 0x99, 0x0f, 0xed, 0xa1, 0x09, 0xc2, 0x54, 0x3e, 0xb3, 0x83, 0x26, 0x33, 0x07, 0x60,
-	0xbf, 0x8c, 0xee, 0x41, 0x5f, 0xb1, 0x65, 0x45, 0xf4, 0x4a, 0x52, 0x1b, 0x5a, 0x84, 0xb7, 0x02,
-	0x7a, 0x0e, 0xd0, 0x78, 0x50, 0x36, 0xbd, 0xbd, 0xe3, 0x64, 0xb2, 0x1d, 0xb8, 0x89, 0x1d, 0xb8,
-	0xc9, 0x59, 0xcd, 0x9c, 0x52, 0x8d, 0xff, 0xd8, 0x31, 0xfa, 0x0c, 0xbd, 0x77, 0x54, 0x13, 0x13,
-	0xc1, 0x35, 0xfb, 0xc1, 0x35, 0xfb, 0xff, 0x34, 0x36, 0xf7, 0xc1, 0x86, 0x9e, 0x6e, 0x73, 0x76,
-	0x43, 0x13, 0x19, 0xf5, 0xb5, 0xcf, 0x7a, 0xf4, 0x16, 0x3a, 0xa6, 0x46, 0x4f, 0xa0, 0x57, 0x7a,
-	0x03, 0xfe, 0x26, 0x0e, 0x9b, 0x9b, 0xa8, 0x9d, 0xe1, 0x06, 0x31, 0x0f, 0x41, 0xaf, 0x55, 0xdc,
-	0x1a, 0xb6, 0xc7, 0x11, 0x36, 0xe5, 0xe8, 0x3d, 0xc0, 0x87, 0xf5, 0x47, 0xa6, 0xf3, 0xd9, 0x29,
-	0x56, 0xe8, 0x16, 0x84, 0x42, 0xd2, 0x94, 0x29, 0x77, 0xaf, 0x11, 0xee, 0x0a, 0x49, 0x67, 0x4a,
-	0xa2, 0x7d, 0x68, 0xe9, 0xb5, 0xbf, 0xbf, 0x96, 0x5e, 0x9b, 0x3f, 0x2b, 0xb8, 0xd2, 0x96, 0x6c,
-	0xbb, 0x21, 0x32, 0xfd, 0x4c, 0xc9, 0x97, 0x6f, 0x7e, 0x5c, 0x25, 0xc1, 0xe5, 0x55, 0x12, 0xfc,
-	0xba, 0x4a, 0x82, 0xaf, 0x9b, 0x64, 0xe7, 0x72, 0x93, 0xec, 0xfc, 0xdc, 0x24, 0x3b, 0x9f, 0x1e,
-	0x2f, 0x99, 0xce, 0x57, 0xf3, 0xc9, 0x82, 0x97, 0xd3, 0xbf, 0x1e, 0xbd, 0x7f, 0xd9, 0x62, 0x5e,
-	0x0b, 0xf3, 0xae, 0x7d, 0xdb, 0xcf, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x91, 0x8b, 0xa5, 0x29,
-	0x1f, 0x04, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x24, 0x8d, 0x93, 0x17, 0xb7, 0x4d, 0x47, 0x6d, 0x31, 0xa5, 0x8a, 0x82, 0x05,
+	0x22, 0x14, 0x91, 0x96, 0x72, 0x41, 0x1c, 0x90, 0x5a, 0x28, 0x6a, 0x0e, 0x48, 0xd5, 0x04, 0x15,
+	0x89, 0x8b, 0x35, 0x89, 0x87, 0x78, 0xd4, 0xc4, 0x63, 0x66, 0x26, 0x21, 0x70, 0xe3, 0xb2, 0xe7,
+	0xfd, 0x43, 0xf6, 0xff, 0xd8, 0x3d, 0xf6, 0xb8, 0xc7, 0x55, 0xfb, 0x8f, 0xac, 0xe6, 0x87, 0x9d,
+	0x74, 0x2b, 0xad, 0x76, 0x2f, 0xce, 0xbc, 0xef, 0xfb, 0xbc, 0xf1, 0xf3, 0x7c, 0x5f, 0x06, 0xf6,
+	0x05, 0x9f, 0x4e, 0x6f, 0x99, 0x3a, 0x71, 0xbf, 0xfd, 0x5c, 0x70, 0xc5, 0x91, 0xef, 0xc2, 0xc3,
+	0x23, 0x45, 0xb3, 0x84, 0x8a, 0x19, 0xcb, 0xd4, 0x89, 0xfa, 0x37, 0xa7, 0xd2, 0x3e, 0x2d, 0x76,
+	0xd8, 0x7d, 0x92, 0x5d, 0x90, 0x29, 0x4b, 0x88, 0xe2, 0xc2, 0x12, 0xd1, 0x77, 0xe0, 0xdf, 0x50,
+	0x21, 0x19, 0xcf, 0xd0, 0x1e, 0x6c, 0x8e, 0xa6, 0x7c, 0x7c, 0x1b, 0x7a, 0x5d, 0xaf, 0x57, 0xc3,
+	0x36, 0x40, 0x6d, 0xa8, 0x92, 0x3c, 0x0f, 0x2b, 0x46, 0xd3, 0xcb, 0xe8, 0x45, 0x15, 0xea, 0x57,
+	0x94, 0x24, 0x54, 0xa0, 0x63, 0xf0, 0x17, 0xb6, 0xda, 0x14, 0xb5, 0xce, 0xda, 0xfd, 0xa2, 0x4f,
+	0xb7, 0x2b, 0x2e, 0x00, 0x74, 0x00, 0xf5, 0x94, 0xb2, 0x49, 0xaa, 0xdc, 0x5e, 0x2e, 0x42, 0x08,
+	0x6a, 0x8a, 0xcd, 0x68, 0x58, 0x35, 0xaa, 0x59, 0xa3, 0x1e, 0xb4, 0xa7, 0x44, 0xaa, 0x38, 0x35,
+	0xaf, 0x89, 0x53, 0x22, 0xd3, 0xb0, 0xd6, 0xf5, 0x7a, 0x01, 0xde, 0xd6, 0xba, 0x7d, 0xfb, 0x15,
+	0x91, 0x69, 0x49, 0x8e, 0xf9, 0x6c, 0xc6, 0x94, 0x25, 0x37, 0x57, 0xe4, 0xcf, 0x46, 0x36, 0xe4,
+	0x67, 0xd0, 0x4c, 0x88, 0x22, 0x16, 0xa9, 0x1b, 0xa4, 0xa1, 0x05, 0x93, 0xfc, 0x12, 0xb6, 0xc7,
+	0x3c, 0x93, 0x34, 0x93, 0x73, 0x69, 0x09, 0xdf, 0x10, 0x5b, 0xa5, 0x6a, 0xb0, 0x4f, 0xa1, 0x41,
+	0xf2, 0xdc, 0x02, 0x0d, 0x03, 0xf8, 0x24, 0xcf, 0x4d, 0xea, 0x18, 0x76, 0x4d, 0x23, 0x82, 0xca,
+	0xf9, 0x54, 0xb9, 0x4d, 0x9a, 0x86, 0xd9, 0xd1, 0x09, 0x6c, 0x75, 0xc3, 0x7e, 0x0d, 0xed, 0x5c,
+	0xf0, 0x9c, 0x4b, 0x2a, 0x62, 0x92, 0x24, 0x82, 0x4a, 0x19, 0x82, 0x45, 0x0b, 0xfd, 0xdc, 0xca,
+	0xba, 0xb1, 0xd2, 0x32, 0xbb, 0x67, 0xcb, 0x36, 0x56, 0xaa, 0x45, 0x63, 0xe3, 0x94, 0xb0, 0x2c,
+	0x66, 0x49, 0x18, 0x74, 0xbd, 0x5e, 0x13, 0xfb, 0x26, 0x1e, 0x24, 0xd1, 0x4b, 0x0f, 0x82, 0x21,
+	0x9b, 0x64, 0x34, 0x71, 0xa6, 0x7d, 0xa5, 0x8d, 0xd0, 0x2b, 0xe7, 0xd9, 0x4e, 0xe9, 0x99, 0x05,
+	0xb0, 0x4b, 0xa3, 0x23, 0x68, 0x4a, 0x36, 0xc9, 0x88, 0x9a, 0x0b, 0x6a, 0x4c, 0x0b, 0xf0, 0x4a,
+	0x40, 0x3f, 0x01, 0x94, 0x3d, 0x48, 0xe3, 0x5e, 0xeb, 0xac, 0xd3, 0x5f, 0x0d, 0x5c, 0xdf, 0x0e,
+	0xe2, 0x4d, 0xc1, 0x0c, 0xa9, 0xc2, 0x6b, 0x15, 0xe8, 0x14, 0xea, 0xd6, 0x34, 0xe3, 0x6c, 0xeb,
+	0x2c, 0x7c, 0x5a, 0x6b, 0xdd, 0xc3, 0x8e, 0x8b, 0xfe, 0x81, 0xc6, 0x6f, 0x54, 0x11, 0x6d, 0xda,
+	0xa3, 0x0f, 0xf6, 0x1e, 0x7d, 0xf0, 0x47, 0x0d, 0xda, 0x17, 0x60, 0xc6, 0x24, 0x5e, 0x4d, 0x86,
+	0x1d, 0xb3, 0x40, 0xab, 0xbf, 0xb8, 0xe9, 0x88, 0xfe, 0xf7, 0xa0, 0xa6, 0x03, 0xf4, 0x2d, 0x34,
+	0x66, 0xae, 0x03, 0x77, 0x78, 0xbb, 0xe5, 0xe1, 0x15, 0xad, 0xe1, 0x12, 0xd1, 0xff, 0x1d, 0xb5,
+	0x94, 0x61, 0xa5, 0x5b, 0xed, 0x05, 0x58, 0x2f, 0xd1, 0x0f, 0x10, 0xb2, 0x4c, 0x51, 0x31, 0xa3,
+	0x09, 0x23, 0x8a, 0xc6, 0x52, 0xe9, 0xa7, 0xe0, 0x5c, 0xe9, 0x23, 0xd4, 0xd8, 0xc1, 0x7a, 0x7e,
+	0xa8, 0xd3, 0x58, 0x67, 0xa3, 0x6b, 0x80, 0xdf, 0x97, 0x7f, 0x30, 0x95, 0x0e, 0x86, 0x58, 0xa2,
+	0x4f, 0xc0, 0xcf, 0x05, 0x8d, 0x99, 0xb4, 0x26, 0x06, 0xb8, 0x9e, 0x0b, 0x3a, 0x90, 0x02, 0x6d,
+	0x43, 0x45, 0x2d, 0x9d, 0x59, 0x15, 0xb5, 0xd4, 0xe7, 0x94, 0x73, 0xa9, 0x0c, 0x59, 0xb5, 0x13,
+	0xab, 0xe3, 0x81, 0x14, 0xd1, 0x33, 0x0f, 0xe0, 0x57, 0x41, 0xe6, 0xc9, 0xb5, 0xe0, 0xfc, 0x2f,
+	0xf4, 0x23, 0x6c, 0x49, 0x33, 0x26, 0xf1, 0xa3, 0xe9, 0xd8, 0x2f, 0x3f, 0x70, 0x7d, 0x88, 0x70,
+	0x20, 0xd7, 0x22, 0xf4, 0x39, 0xd4, 0xcc, 0x99, 0x54, 0x4c, 0xc9, 0x56, 0x59, 0xa2, 0x0f, 0x0d,
+	0xd7, 0x0a, 0xc3, 0xd4, 0x32, 0x66, 0x59, 0x42, 0x97, 0xce, 0x01, 0x5f, 0x2d, 0x07, 0x3a, 0x8c,
+	0xfe, 0x83, 0xbd, 0xcb, 0xbf, 0xe7, 0x6c, 0xc1, 0xc7, 0x44, 0x31, 0x9e, 0x5d, 0x2e, 0x58, 0x42,
+	0xb3, 0x31, 0x45, 0xa7, 0xd0, 0x70, 0x17, 0x00, 0x79, 0x7f, 0x33, 0xbe, 0xc5, 0xce, 0xd7, 0x2a,
+	0x46, 0x61, 0xe5, 0x03, 0x2a, 0x2e, 0x2e, 0x2e, 0x5f, 0xdd, 0x77, 0xbc, 0xbb, 0xfb, 0x8e, 0xf7,
+	0xe6, 0xbe, 0xe3, 0x3d, 0x7f, 0xe8, 0x6c, 0xdc, 0x3d, 0x74, 0x36, 0x5e, 0x3f, 0x74, 0x36, 0xfe,
+	0xfc, 0x66, 0xc2, 0x54, 0x3a, 0x1f, 0xf5, 0xc7, 0x7c, 0x76, 0xf2, 0xce, 0x25, 0xec, 0xee, 0xd2,
+	0x7c, 0x54, 0x08, 0xa3, 0xba, 0xb9, 0x4d, 0xbf, 0x7f, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x94,
+	0x30, 0xe3, 0xaf, 0x05, 0x00, 0x00,
 }
 
 func (m *Version) Marshal() (dAtA []byte, err error) {
@@ -686,6 +832,18 @@ func (m *SignedHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Validators != nil {
 		{
 			size, err := m.Validators.MarshalToSizedBuffer(dAtA[:i])
@@ -787,6 +945,15 @@ func (m *Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IntermediateStateRoots) > 0 {
+		for iNdEx := len(m.IntermediateStateRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IntermediateStateRoots[iNdEx])
+			copy(dAtA[i:], m.IntermediateStateRoots[iNdEx])
+			i = encodeVarintRollkit(dAtA, i, uint64(len(m.IntermediateStateRoots[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Txs) > 0 {
 		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Txs[iNdEx])
@@ -855,6 +1022,105 @@ func (m *TxWithISRs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FraudProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FraudProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintRollkit(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignedHeader != nil {
+		{
+			size, err := m.SignedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EquivocationEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeaderB != nil {
+		{
+			size, err := m.HeaderB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HeaderA != nil {
+		{
+			size, err := m.HeaderA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollkit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRollkit(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRollkit(v)
 	base := offset
@@ -954,6 +1220,10 @@ func (m *SignedHeader) Size() (n int) {
 		l = m.Validators.Size()
 		n += 1 + l + sovRollkit(uint64(l))
 	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
 	return n
 }
 
@@ -996,6 +1266,12 @@ func (m *Data) Size() (n int) {
 			n += 1 + l + sovRollkit(uint64(l))
 		}
 	}
+	if len(m.IntermediateStateRoots) > 0 {
+		for _, b := range m.IntermediateStateRoots {
+			l = len(b)
+			n += 1 + l + sovRollkit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1020,6 +1296,43 @@ func (m *TxWithISRs) Size() (n int) {
 	return n
 }
 
+func (m *FraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		l = m.SignedHeader.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovRollkit(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *EquivocationEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeaderA != nil {
+		l = m.HeaderA.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	if m.HeaderB != nil {
+		l = m.HeaderB.Size()
+		n += 1 + l + sovRollkit(uint64(l))
+	}
+	return n
+}
+
 func sovRollkit(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1677,6 +1990,42 @@ func (m *SignedHeader) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &types.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollkit(dAtA[iNdEx:])
@@ -1949,6 +2298,38 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
 			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateStateRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateStateRoots = append(m.IntermediateStateRoots, make([]byte, postIndex-iNdEx))
+			copy(m.IntermediateStateRoots[len(m.IntermediateStateRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollkit(dAtA[iNdEx:])
@@ -2122,6 +2503,269 @@ func (m *TxWithISRs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FraudProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollkit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FraudProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FraudProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedHeader == nil {
+				m.SignedHeader = &SignedHeader{}
+			}
+			if err := m.SignedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Data{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollkit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollkit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderA == nil {
+				m.HeaderA = &SignedHeader{}
+			}
+			if err := m.HeaderA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollkit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderB == nil {
+				m.HeaderB = &SignedHeader{}
+			}
+			if err := m.HeaderB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollkit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollkit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRollkit(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
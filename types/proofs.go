@@ -0,0 +1,21 @@
+package types
+
+import (
+	cmtypes "github.com/cometbft/cometbft/types"
+)
+
+// ResultBlockTxProofs is the result of the block_tx_proofs RPC method.
+type ResultBlockTxProofs struct {
+	Height uint64 `json:"height"`
+	// Proofs contains inclusion proofs of all transactions in the block, in order of transactions.
+	Proofs []cmtypes.TxProof `json:"proofs"`
+}
+
+// ToCometTxProof converts TxProof to CometBFT TxProof.
+func (p TxProof) ToCometTxProof() cmtypes.TxProof {
+	return cmtypes.TxProof{
+		RootHash: p.RootHash,
+		Data:     cmtypes.Tx(p.Data),
+		Proof:    p.Proof,
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// ResultTxSearchCursor is the result of the tx_search_cursor RPC method.
+type ResultTxSearchCursor struct {
+	Txs []*ctypes.ResultTx `json:"txs"`
+	// NextCursor is the cursor of the next page. It's empty if there are no more results.
+	NextCursor string `json:"next_cursor"`
+}
+
+// ResultBlockSearchCursor is the result of the block_search_cursor RPC method.
+type ResultBlockSearchCursor struct {
+	Blocks []*ctypes.ResultBlock `json:"blocks"`
+	// NextCursor is the cursor of the next page. It's empty if there are no more results.
+	NextCursor string `json:"next_cursor"`
+}
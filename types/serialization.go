@@ -61,10 +61,15 @@ func (sh *SignedHeader) ToProto() (*pb.SignedHeader, error) {
 	if err != nil {
 		return nil, err
 	}
+	var commit *cmproto.Commit
+	if sh.Commit != nil {
+		commit = sh.Commit.ToProto()
+	}
 	return &pb.SignedHeader{
 		Header:     sh.Header.ToProto(),
 		Signature:  sh.Signature[:],
 		Validators: vSet,
+		Commit:     commit,
 	}, nil
 }
 
@@ -84,6 +89,14 @@ func (sh *SignedHeader) FromProto(other *pb.SignedHeader) error {
 
 		sh.Validators = validators
 	}
+
+	if other.Commit != nil {
+		commit, err := types.CommitFromProto(other.Commit)
+		if err != nil {
+			return err
+		}
+		sh.Commit = commit
+	}
 	return nil
 }
 
@@ -178,9 +191,9 @@ func (d *Data) ToProto() *pb.Data {
 		mProto = d.Metadata.ToProto()
 	}
 	return &pb.Data{
-		Metadata: mProto,
-		Txs:      txsToByteSlices(d.Txs),
-		// IntermediateStateRoots: d.IntermediateStateRoots.RawRootsList,
+		Metadata:               mProto,
+		Txs:                    txsToByteSlices(d.Txs),
+		IntermediateStateRoots: d.IntermediateStateRoots.RawRootsList,
 		// Note: Temporarily remove Evidence #896
 		// Evidence:               evidenceToProto(d.Evidence),
 	}
@@ -195,7 +208,7 @@ func (d *Data) FromProto(other *pb.Data) error {
 		d.Metadata.FromProto(other.Metadata)
 	}
 	d.Txs = byteSlicesToTxs(other.Txs)
-	// d.IntermediateStateRoots.RawRootsList = other.IntermediateStateRoots
+	d.IntermediateStateRoots.RawRootsList = other.IntermediateStateRoots
 	// Note: Temporarily remove Evidence #896
 	// d.Evidence = evidenceFromProto(other.Evidence)
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/celestiaorg/go-header"
+	cmmath "github.com/cometbft/cometbft/libs/math"
 	cmtypes "github.com/cometbft/cometbft/types"
 )
 
@@ -17,6 +18,9 @@ type SignedHeader struct {
 	// Note: This is backwards compatible as ABCI exported types are not affected.
 	Signature  Signature
 	Validators *cmtypes.ValidatorSet
+	// Commit is an optional commit of the attester committee (all validators in Validators).
+	// If it's set, header is valid only if it's signed by more than 2/3 of the voting power.
+	Commit *cmtypes.Commit
 }
 
 // New creates a new SignedHeader.
@@ -46,6 +50,19 @@ func (sh *SignedHeader) Verify(untrstH *SignedHeader) error {
 		}
 	}
 
+	if untrstH.Commit != nil {
+		if err := sh.verifyCommit(untrstH); err != nil {
+			return &header.VerifyError{
+				Reason: err,
+			}
+		}
+	} else if sh.Commit != nil {
+		// once attested by the committee, the chain can't continue with headers signed by the sequencer only
+		return &header.VerifyError{
+			Reason: ErrMissingCommit,
+		}
+	}
+
 	if sh.isAdjacent(untrstH) {
 		if err := sh.verifyHeaderHash(untrstH); err != nil {
 			return err
@@ -58,6 +75,22 @@ func (sh *SignedHeader) Verify(untrstH *SignedHeader) error {
 	return nil
 }
 
+// verifyCommit verifies commit of untrusted header against the trusted validator set.
+//
+// If the validator set didn't change, more than 2/3 of the voting power must have signed
+// the untrusted header. Otherwise, like in cometBFT light client, it's enough that
+// validators with more than 1/3 of the trusted voting power signed it.
+func (sh *SignedHeader) verifyCommit(untrstH *SignedHeader) error {
+	if bytes.Equal(sh.Validators.Hash(), untrstH.Validators.Hash()) {
+		return nil // already verified against the same validator set in ValidateBasic
+	}
+	err := sh.Validators.VerifyCommitLightTrusting(untrstH.ChainID(), untrstH.Commit, cmmath.Fraction{Numerator: 1, Denominator: 3})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCommitVerificationFailed, err)
+	}
+	return nil
+}
+
 // verifyHeaderHash verifies the header hash.
 func (sh *SignedHeader) verifyHeaderHash(untrstH *SignedHeader) error {
 	hash := sh.Hash()
@@ -69,7 +102,12 @@ func (sh *SignedHeader) verifyHeaderHash(untrstH *SignedHeader) error {
 
 // verifyCommitHash verifies the commit hash.
 func (sh *SignedHeader) verifyCommitHash(untrstH *SignedHeader) error {
-	expectedCommitHash := sh.Signature.GetCommitHash(&untrstH.Header, sh.ProposerAddress)
+	var expectedCommitHash []byte
+	if sh.Commit != nil {
+		expectedCommitHash = sh.Commit.Hash()
+	} else {
+		expectedCommitHash = sh.Signature.GetCommitHash(&untrstH.Header, sh.ProposerAddress)
+	}
 	if !bytes.Equal(expectedCommitHash, untrstH.LastCommitHash) {
 		return sh.newVerifyError(ErrLastCommitHashMismatch, expectedCommitHash, untrstH.LastCommitHash)
 	}
@@ -109,6 +147,12 @@ var (
 
 	// ErrSignatureEmpty is returned when signature is empty
 	ErrSignatureEmpty = errors.New("signature is empty")
+
+	// ErrCommitVerificationFailed is returned when the commit of the attester committee is invalid
+	ErrCommitVerificationFailed = errors.New("commit verification failed")
+
+	// ErrMissingCommit is returned when the header is not attested by the committee, but it's required
+	ErrMissingCommit = errors.New("header has no commit of the attester committee")
 )
 
 // validatorsEqual compares validator pointers. Starts with the happy case, then falls back to field-by-field comparison.
@@ -139,6 +183,10 @@ func (sh *SignedHeader) ValidateBasic() error {
 		return err
 	}
 
+	if sh.Commit != nil {
+		return sh.validateCommit()
+	}
+
 	// Rollkit vA uses a centralized sequencer, so there should only be one validator
 	if len(sh.Validators.Validators) != 1 {
 		return ErrInvalidValidatorSetLengthMismatch
@@ -163,4 +211,27 @@ func (sh *SignedHeader) ValidateBasic() error {
 	return nil
 }
 
+// validateCommit validates header signed by the sequencer and attested by the validator set.
+func (sh *SignedHeader) validateCommit() error {
+	if !bytes.Equal(sh.ValidatorHash, sh.Validators.Hash()) {
+		return ErrAggregatorSetHashMismatch
+	}
+
+	_, proposer := sh.Validators.GetByAddress(sh.ProposerAddress)
+	if proposer == nil {
+		return ErrProposerNotInValSet
+	}
+
+	vote := sh.Header.MakeCometBFTVote()
+	if !proposer.PubKey.VerifySignature(vote, sh.Signature) {
+		return ErrSignatureVerificationFailed
+	}
+
+	err := sh.Validators.VerifyCommitLight(sh.ChainID(), sh.BlockID(), int64(sh.Height()), sh.Commit) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCommitVerificationFailed, err)
+	}
+	return nil
+}
+
 var _ header.Header[*SignedHeader] = &SignedHeader{}
@@ -290,3 +290,103 @@ func testValidateBasic(t *testing.T, untrustedAdj *SignedHeader, privKey cmcrypt
 		})
 	}
 }
+
+func getCommitteeSignedHeader(t *testing.T, keys []cmcrypto.PrivKey, height uint64, signers int) *SignedHeader {
+	validators := make([]*cmtypes.Validator, len(keys))
+	for i, key := range keys {
+		validators[i] = cmtypes.NewValidator(key.PubKey(), 10)
+	}
+	valSet := cmtypes.NewValidatorSet(validators)
+	// the first key belongs to the sequencer
+	proposerKey := keys[0]
+
+	sh := &SignedHeader{
+		Header:     GetRandomHeader(),
+		Validators: valSet,
+	}
+	sh.BaseHeader.Height = height
+	sh.ProposerAddress = proposerKey.PubKey().Address()
+	sh.ValidatorHash = valSet.Hash()
+
+	attestations := make([]Attestation, 0, signers)
+	for _, key := range keys {
+		idx, val := valSet.GetByAddress(key.PubKey().Address())
+		if len(attestations) == signers {
+			continue
+		}
+		attestation, err := sh.Attest(val.Address, idx, key.Sign)
+		require.NoError(t, err)
+		attestations = append(attestations, attestation)
+	}
+	signature, err := GetSignature(sh.Header, proposerKey)
+	require.NoError(t, err)
+	sh.Signature = *signature
+	sh.Commit = NewCommit(&sh.Header, valSet, attestations)
+	return sh
+}
+
+func TestSignedHeaderCommittee(t *testing.T) {
+	keys := []cmcrypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+
+	t.Run("valid commit", func(t *testing.T) {
+		sh := getCommitteeSignedHeader(t, keys, 1, 3)
+		assert.NoError(t, sh.ValidateBasic())
+
+		blob, err := sh.MarshalBinary()
+		require.NoError(t, err)
+		decoded := new(SignedHeader)
+		require.NoError(t, decoded.UnmarshalBinary(blob))
+		require.NotNil(t, decoded.Commit)
+		assert.Equal(t, sh.Commit.Hash(), decoded.Commit.Hash())
+		assert.NoError(t, decoded.ValidateBasic())
+	})
+
+	t.Run("not enough voting power", func(t *testing.T) {
+		sh := getCommitteeSignedHeader(t, keys, 1, 2)
+		assert.ErrorIs(t, sh.ValidateBasic(), ErrCommitVerificationFailed)
+	})
+
+	t.Run("invalid attestation", func(t *testing.T) {
+		sh := getCommitteeSignedHeader(t, keys, 1, 3)
+		for i := range sh.Commit.Signatures {
+			if sh.Commit.Signatures[i].BlockIDFlag == cmtypes.BlockIDFlagCommit {
+				sh.Commit.Signatures[i].Signature = GetRandomBytes(64)
+				break
+			}
+		}
+		assert.ErrorIs(t, sh.ValidateBasic(), ErrCommitVerificationFailed)
+	})
+
+	t.Run("validator hash mismatch", func(t *testing.T) {
+		sh := getCommitteeSignedHeader(t, keys, 1, 4)
+		sh.ValidatorHash = GetRandomBytes(32)
+		assert.ErrorIs(t, sh.ValidateBasic(), ErrAggregatorSetHashMismatch)
+	})
+
+	t.Run("verify adjacent", func(t *testing.T) {
+		trusted := getCommitteeSignedHeader(t, keys, 1, 4)
+		untrusted := getCommitteeSignedHeader(t, keys, 2, 3)
+		untrusted.LastHeaderHash = trusted.Hash()
+		untrusted.LastCommitHash = GetRandomBytes(32)
+		assert.ErrorIs(t, trusted.Verify(untrusted), ErrLastCommitHashMismatch)
+	})
+
+	t.Run("verify without commit", func(t *testing.T) {
+		trusted := getCommitteeSignedHeader(t, keys, 1, 4)
+		untrusted := getCommitteeSignedHeader(t, keys, 10, 4)
+		untrusted.Commit = nil
+		assert.ErrorIs(t, trusted.Verify(untrusted), ErrMissingCommit)
+	})
+
+	t.Run("verify with different validator set", func(t *testing.T) {
+		trusted := getCommitteeSignedHeader(t, keys, 1, 4)
+		otherKeys := []cmcrypto.PrivKey{keys[0], ed25519.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+		untrusted := getCommitteeSignedHeader(t, otherKeys, 10, 4)
+		require.NoError(t, untrusted.ValidateBasic())
+		assert.ErrorIs(t, trusted.Verify(untrusted), ErrCommitVerificationFailed)
+
+		otherKeys = []cmcrypto.PrivKey{keys[0], keys[1], ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+		untrusted = getCommitteeSignedHeader(t, otherKeys, 10, 4)
+		assert.NoError(t, trusted.Verify(untrusted))
+	})
+}
@@ -35,15 +35,8 @@ func (txs Txs) ToSliceOfBytes() [][]byte {
 
 // Proof returns a simple merkle proof for this node.
 // Panics if i < 0 or i >= len(txs)
-// TODO: optimize this!
 func (txs Txs) Proof(i int) TxProof {
-	l := len(txs)
-	bzs := make([][]byte, l)
-	for i := 0; i < l; i++ {
-		bzs[i] = txs[i].Hash()
-	}
-	root, proofs := merkle.ProofsFromByteSlices(bzs)
-
+	root, proofs := txs.merkleProofs()
 	return TxProof{
 		RootHash: root,
 		Data:     txs[i],
@@ -51,6 +44,29 @@ func (txs Txs) Proof(i int) TxProof {
 	}
 }
 
+// Proofs returns inclusion proofs of all transactions, computing the Merkle tree only once.
+func (txs Txs) Proofs() []TxProof {
+	root, proofs := txs.merkleProofs()
+	res := make([]TxProof, len(txs))
+	for i := range txs {
+		res[i] = TxProof{
+			RootHash: root,
+			Data:     txs[i],
+			Proof:    *proofs[i],
+		}
+	}
+	return res
+}
+
+// merkleProofs returns the root of the Merkle tree of transaction hashes and proofs of all leaves.
+func (txs Txs) merkleProofs() ([]byte, []*merkle.Proof) {
+	bzs := make([][]byte, len(txs))
+	for i := range txs {
+		bzs[i] = txs[i].Hash()
+	}
+	return merkle.ProofsFromByteSlices(bzs)
+}
+
 // TxProof represents a Merkle proof of the presence of a transaction in the Merkle tree.
 type TxProof struct {
 	RootHash cmbytes.HexBytes `json:"root_hash"`